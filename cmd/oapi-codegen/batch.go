@@ -0,0 +1,320 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// batchConfiguration is a configuration file which lists many generation
+// jobs, so that several specs can be generated by a single run.
+type batchConfiguration struct {
+	Jobs []batchJob `yaml:"jobs"`
+}
+
+// batchJob is a single job of a batchConfiguration. All its paths are
+// relative to the directory of the batch configuration file.
+type batchJob struct {
+	configuration `yaml:",inline"`
+
+	// Spec is the path of the OpenAPI spec to generate code for.
+	Spec string `yaml:"spec"`
+
+	// ImportPath is the Go package path of the generated code. References to
+	// this job's spec from the other jobs are mapped to this package. When
+	// empty, it is derived from the output location and the enclosing go.mod.
+	ImportPath string `yaml:"import-path,omitempty"`
+}
+
+// batchJobError reports the failure of a single batch job.
+type batchJobError struct {
+	Spec string
+	Err  error
+}
+
+func (e *batchJobError) Error() string {
+	return fmt.Sprintf("error in job for %s: %s", e.Spec, e.Err)
+}
+
+func (e *batchJobError) Unwrap() error {
+	return e.Err
+}
+
+// loadBatchConfiguration reads the configuration file at configFile as a batch
+// configuration. It returns nil if the file isn't a batch configuration,
+// which is the case when it doesn't have a top level jobs list.
+func loadBatchConfiguration(configFile string) (*batchConfiguration, error) {
+	buf, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file '%s': %w", configFile, err)
+	}
+
+	var top map[string]interface{}
+	if err := yaml.Unmarshal(buf, &top); err != nil {
+		// Leave reporting malformed files to the regular config parsing.
+		return nil, nil
+	}
+	if _, ok := top["jobs"]; !ok {
+		return nil, nil
+	}
+
+	var batch batchConfiguration
+	if err := yaml.UnmarshalStrict(buf, &batch); err != nil {
+		return nil, fmt.Errorf("error parsing '%s' as batch configuration: %w", configFile, err)
+	}
+	return &batch, nil
+}
+
+// runBatch runs all the jobs of batch, resolving the paths of the jobs
+// relative to the directory of configFile. A failing job doesn't stop the
+// others from running; one error is returned for each job which failed.
+func runBatch(configFile string, batch batchConfiguration) []error {
+	baseDir := filepath.Dir(configFile)
+
+	jobs := make([]batchJob, len(batch.Jobs))
+	for i, job := range batch.Jobs {
+		job.Spec = resolveBatchPath(baseDir, job.Spec)
+		job.OutputFile = resolveBatchPath(baseDir, job.OutputFile)
+		job.OutputDir = resolveBatchPath(baseDir, job.OutputDir)
+		job.OutputOptions.UserTemplates = resolveUserTemplates(baseDir, job.OutputOptions.UserTemplates)
+		jobs[i] = job
+	}
+
+	var errs []error
+	for i := range jobs {
+		if err := runBatchJob(jobs, i); err != nil {
+			errs = append(errs, &batchJobError{Spec: batch.Jobs[i].Spec, Err: err})
+		}
+	}
+	return errs
+}
+
+// runBatchJob runs the i'th job of jobs. The other jobs are used to resolve
+// the import mapping of references to their specs.
+func runBatchJob(jobs []batchJob, i int) error {
+	job := jobs[i]
+	if job.Spec == "" {
+		return errors.New("spec must be specified")
+	}
+	if job.OutputFile == "" && job.OutputDir == "" {
+		return errors.New("one of output and output-dir must be specified")
+	}
+	if job.OutputFile != "" && job.OutputDir != "" {
+		return errors.New("only one of output and output-dir may be specified")
+	}
+
+	opts := job.configuration
+	opts.Configuration = opts.UpdateDefaults()
+	if opts.PackageName == "" {
+		opts.PackageName = packageNameFromSpec(job.Spec)
+	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+
+	importMapping, err := batchImportMapping(jobs, i)
+	if err != nil {
+		return err
+	}
+	opts.ImportMapping = importMapping
+
+	return generate(job.Spec, opts)
+}
+
+// batchImportMapping returns the import mapping of the i'th job of jobs. It
+// starts from the mapping configured for the job, and adds the package of
+// every other job whose spec the job references.
+func batchImportMapping(jobs []batchJob, i int) (map[string]string, error) {
+	job := jobs[i]
+
+	importMapping := make(map[string]string, len(job.ImportMapping))
+	for specPath, packagePath := range job.ImportMapping {
+		importMapping[specPath] = packagePath
+	}
+
+	if isURL(job.Spec) {
+		return importMapping, nil
+	}
+	refs, err := externalRefs(job.Spec)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if _, ok := importMapping[ref]; ok || isURL(ref) {
+			continue
+		}
+		refPath := filepath.Join(filepath.Dir(job.Spec), ref)
+		for j, other := range jobs {
+			if j == i || isURL(other.Spec) || !samePath(refPath, other.Spec) {
+				continue
+			}
+			importPath := other.ImportPath
+			if importPath == "" {
+				outputDir := other.OutputDir
+				if outputDir == "" {
+					outputDir = filepath.Dir(other.OutputFile)
+				}
+				importPath, err = goImportPath(outputDir)
+				if err != nil {
+					return nil, fmt.Errorf("error resolving import path of %s: %w", ref, err)
+				}
+			}
+			importMapping[ref] = importPath
+			break
+		}
+	}
+	return importMapping, nil
+}
+
+// externalRefs returns the files referenced by the $refs of the spec at
+// specPath, exactly as they are written in the spec.
+func externalRefs(specPath string) ([]string, error) {
+	buf, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec '%s': %w", specPath, err)
+	}
+	// JSON is a subset of YAML, so this handles both formats.
+	var doc interface{}
+	if err := yaml.Unmarshal(buf, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing spec '%s': %w", specPath, err)
+	}
+
+	found := map[string]bool{}
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[interface{}]interface{}:
+			for key, value := range v {
+				if ref, ok := value.(string); ok && key == "$ref" {
+					if file := strings.SplitN(ref, "#", 2)[0]; file != "" {
+						found[file] = true
+					}
+					continue
+				}
+				walk(value)
+			}
+		case []interface{}:
+			for _, value := range v {
+				walk(value)
+			}
+		}
+	}
+	walk(doc)
+
+	refs := make([]string, 0, len(found))
+	for ref := range found {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs, nil
+}
+
+// goImportPath returns the Go import path of the package in dir, based on the
+// module path declared by the nearest go.mod above it.
+func goImportPath(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for moduleDir := absDir; ; {
+		buf, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			modulePath := modulePathFromGoMod(buf)
+			if modulePath == "" {
+				return "", fmt.Errorf("no module path found in %s", filepath.Join(moduleDir, "go.mod"))
+			}
+			rel, err := filepath.Rel(moduleDir, absDir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modulePath, filepath.ToSlash(rel)), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+
+		parent := filepath.Dir(moduleDir)
+		if parent == moduleDir {
+			return "", fmt.Errorf("no go.mod found for %s; please specify the import-path of the job", dir)
+		}
+		moduleDir = parent
+	}
+}
+
+// modulePathFromGoMod returns the module path declared in the contents of a
+// go.mod file, or an empty string if there isn't any.
+func modulePathFromGoMod(goMod []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(goMod))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "module") {
+			continue
+		}
+		modulePath := strings.TrimSpace(strings.TrimPrefix(line, "module"))
+		if i := strings.Index(modulePath, "//"); i >= 0 {
+			modulePath = strings.TrimSpace(modulePath[:i])
+		}
+		return strings.Trim(modulePath, "\"`")
+	}
+	return ""
+}
+
+// resolveBatchPath resolves p relative to baseDir, unless it is empty,
+// absolute or a URL.
+func resolveBatchPath(baseDir, p string) string {
+	if p == "" || filepath.IsAbs(p) || isURL(p) {
+		return p
+	}
+	return filepath.Join(baseDir, p)
+}
+
+// resolveUserTemplates returns user templates with the paths of those given
+// as files resolved by resolveBatchPath. Templates given inline, which span
+// several lines, are left as they are.
+func resolveUserTemplates(baseDir string, templates map[string]string) map[string]string {
+	if len(templates) == 0 {
+		return templates
+	}
+	resolved := make(map[string]string, len(templates))
+	for name, tmpl := range templates {
+		if !strings.Contains(tmpl, "\n") {
+			tmpl = resolveBatchPath(baseDir, tmpl)
+		}
+		resolved[name] = tmpl
+	}
+	return resolved
+}
+
+func isURL(p string) bool {
+	u, err := url.Parse(p)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func samePath(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	specDir, err := filepath.Abs("../../internal/test/externalref")
+	require.NoError(t, err)
+	outDir := t.TempDir()
+
+	config := `
+jobs:
+  - spec: ` + filepath.Join(specDir, "spec.yaml") + `
+    package: externalref
+    generate:
+      models: true
+    output-options:
+      skip-prune: true
+    output: externalref/externalref.gen.go
+  - spec: ` + filepath.Join(specDir, "packageA", "spec.yaml") + `
+    package: packagea
+    generate:
+      models: true
+    output-options:
+      skip-prune: true
+      user-templates:
+        typedef.tmpl: templates/typedef.tmpl
+    output: packageA/externalref.gen.go
+    import-path: example.com/batch/packageA
+  - spec: ` + filepath.Join(specDir, "packageB", "spec.yaml") + `
+    package: packageb
+    generate:
+      models: true
+    output-options:
+      skip-prune: true
+    output-dir: packageB
+    import-path: example.com/batch/packageB
+  - spec: missing.yaml
+    package: missing
+    generate:
+      models: true
+    output: missing/missing.gen.go
+`
+	configFile := filepath.Join(outDir, "batch.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(config), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(outDir, "externalref"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(outDir, "packageA"), 0o755))
+	// User templates given as files are relative to the configuration file
+	// too.
+	typedef, err := os.ReadFile("../../pkg/codegen/templates/typedef.tmpl")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(outDir, "templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "templates", "typedef.tmpl"), append([]byte("// Custom type definitions\n"), typedef...), 0o644))
+
+	batch, err := loadBatchConfiguration(configFile)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Jobs, 4)
+
+	// Only the job with the missing spec fails:
+	errs := runBatch(configFile, *batch)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "missing.yaml")
+
+	// References between the jobs are mapped to the packages of the jobs:
+	code, err := os.ReadFile(filepath.Join(outDir, "externalref", "externalref.gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), `externalRef0 "example.com/batch/packageA"`)
+	assert.Contains(t, string(code), `externalRef1 "example.com/batch/packageB"`)
+	assert.Contains(t, string(code), "ObjectA *externalRef0.ObjectA")
+
+	code, err = os.ReadFile(filepath.Join(outDir, "packageA", "externalref.gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), `externalRef0 "example.com/batch/packageB"`)
+	assert.Contains(t, string(code), "// Custom type definitions")
+
+	_, err = os.Stat(filepath.Join(outDir, "packageB", "types.gen.go"))
+	assert.NoError(t, err)
+}
+
+func TestLoadBatchConfigurationIgnoresRegularConfig(t *testing.T) {
+	batch, err := loadBatchConfiguration("../../internal/test/externalref/externalref.cfg.yaml")
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+}
+
+func TestGoImportPath(t *testing.T) {
+	importPath, err := goImportPath("../../internal/test/externalref/packageA")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/deepmap/oapi-codegen/internal/test/externalref/packageA", importPath)
+
+	importPath, err = goImportPath("../..")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/deepmap/oapi-codegen", importPath)
+}
@@ -0,0 +1,555 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// The kinds of the changes of the Go API which break code using it.
+const (
+	changeTypeRemoved          = "type-removed"
+	changeTypeRenamed          = "type-renamed"
+	changeTypeKindChanged      = "type-kind-changed"
+	changeTypeChanged          = "type-changed"
+	changeFieldRemoved         = "field-removed"
+	changeFieldTypeChanged     = "field-type-changed"
+	changeFieldPointerChanged  = "field-pointer-changed"
+	changeMethodRemoved        = "method-removed"
+	changeMethodChanged        = "method-signature-changed"
+	changeInterfaceMethodAdded = "interface-method-added"
+	changeFunctionRemoved      = "function-removed"
+	changeFunctionChanged      = "function-signature-changed"
+	changeConstantRemoved      = "constant-removed"
+	changeConstantValueChanged = "constant-value-changed"
+	changeConstantTypeChanged  = "constant-type-changed"
+	changeVariableRemoved      = "variable-removed"
+	changeVariableTypeChanged  = "variable-type-changed"
+)
+
+const (
+	breakingChangesSubcommand = "breaking-changes"
+	// breakingChangesExitCode is the exit code of the breaking-changes
+	// subcommand when it finds breaking changes, like that of -check when
+	// generated code is out of date.
+	breakingChangesExitCode = 1
+)
+
+// apiChange is a change of the exported Go API of generated code, which breaks
+// code using it.
+type apiChange struct {
+	Kind   string `json:"kind"`
+	Symbol string `json:"symbol"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// breakingChangesReport is the report of the breaking-changes subcommand.
+type breakingChangesReport struct {
+	OldSpec  string      `json:"old-spec"`
+	NewSpec  string      `json:"new-spec"`
+	Breaking bool        `json:"breaking"`
+	Changes  []apiChange `json:"changes"`
+}
+
+// apiType is an exported type of generated code.
+type apiType struct {
+	Alias      bool
+	Kind       string            // struct, interface, or type for any other type
+	Definition string            // The type it's defined as, or aliases
+	Fields     map[string]string // The exported fields of a struct, with their types
+	Methods    map[string]string // The methods of an interface, with their signatures
+}
+
+// apiSurface is the exported Go API of generated code.
+type apiSurface struct {
+	Types     map[string]apiType
+	Functions map[string]string // Signatures of functions, by name
+	Methods   map[string]string // Signatures of methods, by Type.Method
+	Constants map[string]apiConstant
+	Variables map[string]string // Types of variables, by name
+}
+
+// apiConstant is an exported constant of generated code.
+type apiConstant struct {
+	Type  string
+	Value string
+}
+
+// runBreakingChanges runs the breaking-changes subcommand with its arguments,
+// and returns the exit code of the command.
+func runBreakingChanges(args []string) int {
+	flags := flag.NewFlagSet(breakingChangesSubcommand, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: oapi-codegen %s [flags] old-spec new-spec\n\n", breakingChangesSubcommand)
+		fmt.Fprintf(flags.Output(), "Reports the changes of the generated Go API which break code using it, as JSON.\n\n")
+		flags.PrintDefaults()
+	}
+	configFile := flags.String("config", "", "A YAML config file that controls oapi-codegen behavior, for both specs.")
+	oldConfigFile := flags.String("old-config", "", "A YAML config file for the old spec, when it differs from -config.")
+	output := flags.String("o", "", "Where to output the report, stdout is default.")
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	oldSpec, newSpec := flags.Arg(0), flags.Arg(1)
+
+	newConfig, err := loadBreakingChangesConfiguration(*configFile, newSpec)
+	if err != nil {
+		errExit("%s\n", err)
+	}
+	oldConfig := newConfig
+	if *oldConfigFile != "" {
+		if oldConfig, err = loadBreakingChangesConfiguration(*oldConfigFile, oldSpec); err != nil {
+			errExit("%s\n", err)
+		}
+	}
+
+	changes, err := breakingChanges(oldSpec, oldConfig, newSpec, newConfig)
+	if err != nil {
+		errExit("%s\n", err)
+	}
+
+	report := breakingChangesReport{
+		OldSpec:  oldSpec,
+		NewSpec:  newSpec,
+		Breaking: len(changes) > 0,
+		Changes:  changes,
+	}
+	if report.Changes == nil {
+		report.Changes = []apiChange{}
+	}
+	buf, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		errExit("error marshaling report: %s\n", err)
+	}
+	buf = append(buf, '\n')
+	if *output == "" {
+		_, _ = os.Stdout.Write(buf)
+	} else if err := os.WriteFile(*output, buf, 0o644); err != nil {
+		errExit("error writing report: %s\n", err)
+	}
+
+	if report.Breaking {
+		return breakingChangesExitCode
+	}
+	return 0
+}
+
+// loadBreakingChangesConfiguration loads the configuration for generating the
+// code of the spec at specPath from configFile, defaulting to the same
+// configuration as the generator when there's none.
+func loadBreakingChangesConfiguration(configFile, specPath string) (codegen.Configuration, error) {
+	var opts configuration
+	if configFile != "" {
+		buf, err := os.ReadFile(configFile)
+		if err != nil {
+			return codegen.Configuration{}, fmt.Errorf("error reading config file '%s': %w", configFile, err)
+		}
+		if err := yaml.Unmarshal(buf, &opts); err != nil {
+			return codegen.Configuration{}, fmt.Errorf("error parsing '%s' as YAML: %w", configFile, err)
+		}
+	} else {
+		opts.Generate = codegen.GenerateOptions{
+			EchoServer:   true,
+			Client:       true,
+			Models:       true,
+			EmbeddedSpec: true,
+		}
+	}
+
+	cfg := opts.UpdateDefaults()
+	if cfg.PackageName == "" {
+		cfg.PackageName = packageNameFromSpec(specPath)
+	}
+	if err := cfg.Validate(); err != nil {
+		return codegen.Configuration{}, fmt.Errorf("configuration error: %w", err)
+	}
+	return cfg, nil
+}
+
+// breakingChanges generates the code of the old and new specs with their
+// configurations, and returns the changes of its exported API which break code
+// using it.
+func breakingChanges(oldSpec string, oldConfig codegen.Configuration, newSpec string, newConfig codegen.Configuration) ([]apiChange, error) {
+	oldCode, err := generateCode(oldSpec, oldConfig)
+	if err != nil {
+		return nil, err
+	}
+	newCode, err := generateCode(newSpec, newConfig)
+	if err != nil {
+		return nil, err
+	}
+	return compareAPIs(oldCode, newCode)
+}
+
+// generateCode generates the code for the spec at specPath in memory.
+func generateCode(specPath string, cfg codegen.Configuration) (string, error) {
+	swagger, err := util.LoadSwagger(specPath)
+	if err != nil {
+		return "", fmt.Errorf("error loading swagger spec in %s: %w", specPath, err)
+	}
+	code, err := codegen.Generate(swagger, cfg)
+	if err != nil {
+		return "", fmt.Errorf("error generating code for %s: %w", specPath, err)
+	}
+	return code, nil
+}
+
+// compareAPIs returns the changes of the exported API of oldCode, in newCode,
+// which break code using it.
+func compareAPIs(oldCode, newCode string) ([]apiChange, error) {
+	oldAPI, err := parseAPI(oldCode)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing old code: %w", err)
+	}
+	newAPI, err := parseAPI(newCode)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing new code: %w", err)
+	}
+
+	var changes []apiChange
+	add := func(kind, symbol, oldValue, newValue string) {
+		changes = append(changes, apiChange{Kind: kind, Symbol: symbol, Old: oldValue, New: newValue})
+	}
+
+	// Types removed from the old API may have been renamed, when a new type
+	// has the very same definition.
+	added := map[string][]string{}
+	for name, t := range newAPI.Types {
+		if _, found := oldAPI.Types[name]; !found {
+			added[t.key()] = append(added[t.key()], name)
+		}
+	}
+
+	for _, name := range sortedKeys(oldAPI.Types) {
+		oldType := oldAPI.Types[name]
+		newType, found := newAPI.Types[name]
+		if !found {
+			if candidates := added[oldType.key()]; len(candidates) == 1 {
+				add(changeTypeRenamed, name, name, candidates[0])
+			} else {
+				add(changeTypeRemoved, name, oldType.describe(), "")
+			}
+			continue
+		}
+
+		if oldType.Alias != newType.Alias || oldType.Kind != newType.Kind {
+			add(changeTypeKindChanged, name, oldType.describe(), newType.describe())
+			continue
+		}
+		switch oldType.Kind {
+		case "struct":
+			for _, field := range sortedKeys(oldType.Fields) {
+				oldField := oldType.Fields[field]
+				newField, found := newType.Fields[field]
+				symbol := name + "." + field
+				switch {
+				case !found:
+					add(changeFieldRemoved, symbol, oldField, "")
+				case oldField == newField:
+				case strings.TrimPrefix(oldField, "*") == strings.TrimPrefix(newField, "*"):
+					add(changeFieldPointerChanged, symbol, oldField, newField)
+				default:
+					add(changeFieldTypeChanged, symbol, oldField, newField)
+				}
+			}
+		case "interface":
+			for _, method := range sortedKeys(oldType.Methods) {
+				symbol := name + "." + method
+				if newMethod, found := newType.Methods[method]; !found {
+					add(changeMethodRemoved, symbol, oldType.Methods[method], "")
+				} else if newMethod != oldType.Methods[method] {
+					add(changeMethodChanged, symbol, oldType.Methods[method], newMethod)
+				}
+			}
+			// Implementations of the interface lack the methods added to it.
+			for _, method := range sortedKeys(newType.Methods) {
+				if _, found := oldType.Methods[method]; !found {
+					add(changeInterfaceMethodAdded, name+"."+method, "", newType.Methods[method])
+				}
+			}
+		default:
+			if oldType.Definition != newType.Definition {
+				add(changeTypeChanged, name, oldType.describe(), newType.describe())
+			}
+		}
+	}
+
+	for _, symbol := range sortedKeys(oldAPI.Methods) {
+		// The methods of removed types aren't reported on their own.
+		if _, found := newAPI.Types[strings.SplitN(symbol, ".", 2)[0]]; !found {
+			continue
+		}
+		if newMethod, found := newAPI.Methods[symbol]; !found {
+			add(changeMethodRemoved, symbol, oldAPI.Methods[symbol], "")
+		} else if newMethod != oldAPI.Methods[symbol] {
+			add(changeMethodChanged, symbol, oldAPI.Methods[symbol], newMethod)
+		}
+	}
+
+	for _, name := range sortedKeys(oldAPI.Functions) {
+		if newFunction, found := newAPI.Functions[name]; !found {
+			add(changeFunctionRemoved, name, oldAPI.Functions[name], "")
+		} else if newFunction != oldAPI.Functions[name] {
+			add(changeFunctionChanged, name, oldAPI.Functions[name], newFunction)
+		}
+	}
+
+	for _, name := range sortedKeys(oldAPI.Constants) {
+		oldConstant := oldAPI.Constants[name]
+		newConstant, found := newAPI.Constants[name]
+		switch {
+		case !found:
+			add(changeConstantRemoved, name, oldConstant.Value, "")
+		case oldConstant.Type != newConstant.Type:
+			add(changeConstantTypeChanged, name, oldConstant.Type, newConstant.Type)
+		case oldConstant.Value != newConstant.Value:
+			add(changeConstantValueChanged, name, oldConstant.Value, newConstant.Value)
+		}
+	}
+
+	for _, name := range sortedKeys(oldAPI.Variables) {
+		if newVariable, found := newAPI.Variables[name]; !found {
+			add(changeVariableRemoved, name, oldAPI.Variables[name], "")
+		} else if newVariable != oldAPI.Variables[name] {
+			add(changeVariableTypeChanged, name, oldAPI.Variables[name], newVariable)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Symbol < changes[j].Symbol
+	})
+	return changes, nil
+}
+
+// parseAPI parses generated code, and returns its exported API.
+func parseAPI(code string) (*apiSurface, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", code, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	format := func(node ast.Node) string {
+		var buf bytes.Buffer
+		_ = printer.Fprint(&buf, fset, node)
+		return buf.String()
+	}
+
+	api := &apiSurface{
+		Types:     map[string]apiType{},
+		Functions: map[string]string{},
+		Methods:   map[string]string{},
+		Constants: map[string]apiConstant{},
+		Variables: map[string]string{},
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() {
+				continue
+			}
+			if decl.Recv == nil {
+				api.Functions[decl.Name.Name] = signature(decl.Type, format)
+				continue
+			}
+			receiver := decl.Recv.List[0].Type
+			receiverName := receiverTypeName(receiver)
+			if ast.IsExported(receiverName) {
+				api.Methods[receiverName+"."+decl.Name.Name] = "(" + format(receiver) + ") " + signature(decl.Type, format)
+			}
+
+		case *ast.GenDecl:
+			var lastType, lastValue string
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.IsExported() {
+						api.Types[spec.Name.Name] = newAPIType(spec, format)
+					}
+				case *ast.ValueSpec:
+					// Constants without a type and value repeat those of
+					// the previous ones.
+					if spec.Type != nil || len(spec.Values) > 0 {
+						lastType, lastValue = "", ""
+						if spec.Type != nil {
+							lastType = format(spec.Type)
+						}
+					}
+					for i, name := range spec.Names {
+						if !name.IsExported() {
+							continue
+						}
+						if decl.Tok == token.VAR {
+							api.Variables[name.Name] = lastType
+							continue
+						}
+						if i < len(spec.Values) {
+							lastValue = format(spec.Values[i])
+						}
+						api.Constants[name.Name] = apiConstant{Type: lastType, Value: lastValue}
+					}
+				}
+			}
+		}
+	}
+	return api, nil
+}
+
+// newAPIType returns the apiType of the declaration of an exported type.
+func newAPIType(spec *ast.TypeSpec, format func(ast.Node) string) apiType {
+	t := apiType{
+		Alias:      spec.Assign.IsValid(),
+		Kind:       "type",
+		Definition: format(spec.Type),
+	}
+	if spec.TypeParams != nil {
+		t.Definition = format(spec.TypeParams) + " " + t.Definition
+	}
+	if t.Alias {
+		return t
+	}
+
+	switch typ := spec.Type.(type) {
+	case *ast.StructType:
+		t.Kind = "struct"
+		t.Fields = map[string]string{}
+		for _, field := range typ.Fields.List {
+			fieldType := format(field.Type)
+			if len(field.Names) == 0 {
+				// Embedded fields are named after their type.
+				if name := receiverTypeName(field.Type); ast.IsExported(name) {
+					t.Fields[name] = fieldType
+				}
+				continue
+			}
+			for _, name := range field.Names {
+				if name.IsExported() {
+					t.Fields[name.Name] = fieldType
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		t.Kind = "interface"
+		t.Methods = map[string]string{}
+		for _, method := range typ.Methods.List {
+			if len(method.Names) == 0 {
+				embedded := format(method.Type)
+				t.Methods[embedded] = embedded
+				continue
+			}
+			if funcType, ok := method.Type.(*ast.FuncType); ok {
+				for _, name := range method.Names {
+					if name.IsExported() {
+						t.Methods[name.Name] = signature(funcType, format)
+					}
+				}
+			}
+		}
+	}
+	return t
+}
+
+// key returns what identifies the definition of t, regardless of its name.
+func (t apiType) key() string {
+	if t.Alias {
+		return "= " + t.Definition
+	}
+	return t.Definition
+}
+
+// describe returns the definition of t for reports, where structs and
+// interfaces are only named by their kind, since their fields and methods are
+// compared one by one.
+func (t apiType) describe() string {
+	if !t.Alias && t.Kind != "type" {
+		return t.Kind
+	}
+	return t.key()
+}
+
+// signature returns the signature of a function type, without the names of its
+// parameters and results, which callers don't depend on.
+func signature(funcType *ast.FuncType, format func(ast.Node) string) string {
+	types := func(fields *ast.FieldList) []string {
+		var list []string
+		if fields == nil {
+			return list
+		}
+		for _, field := range fields.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				list = append(list, format(field.Type))
+			}
+		}
+		return list
+	}
+
+	s := "func(" + strings.Join(types(funcType.Params), ", ") + ")"
+	switch results := types(funcType.Results); len(results) {
+	case 0:
+	case 1:
+		s += " " + results[0]
+	default:
+		s += " (" + strings.Join(results, ", ") + ")"
+	}
+	return s
+}
+
+// receiverTypeName returns the name of the type of a receiver, or of an
+// embedded field.
+func receiverTypeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(expr.X)
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
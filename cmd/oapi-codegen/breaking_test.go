@@ -0,0 +1,217 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+)
+
+func TestCompareAPIs(t *testing.T) {
+	oldCode := `package api
+
+type Pet struct {
+	Name *string
+	Age  int
+	Tag  string
+	id   string
+}
+
+type Owner struct {
+	Pets []Pet
+}
+
+type Kind string
+
+type Alias = Pet
+
+type ClientInterface interface {
+	ListPets(ctx context.Context, limit *int) (*http.Response, error)
+	DeletePet(ctx context.Context, id string) (*http.Response, error)
+}
+
+const (
+	KindCat Kind = "cat"
+	KindDog Kind = "dog"
+	Limit        = 10
+)
+
+var Swagger []string
+
+func (p Pet) Describe(verbose bool) string { return "" }
+
+func NewClient(server string) (*Client, error) { return nil, nil }
+
+func helper() {}
+`
+	newCode := `package api
+
+type Pet struct {
+	Name string
+	Age  int64
+	Tag  string
+}
+
+type PetOwner struct {
+	Pets []Pet
+}
+
+type Kind int
+
+type Alias Pet
+
+type ClientInterface interface {
+	ListPets(ctx context.Context, limit int) (*http.Response, error)
+	UpdatePet(ctx context.Context, id string) (*http.Response, error)
+}
+
+const (
+	KindCat Kind = "kitty"
+	Limit   int  = 10
+)
+
+func (p *Pet) Describe(verbose bool) string { return "" }
+
+func NewClient(baseURL string) (*Client, error) { return nil, nil }
+`
+
+	changes, err := compareAPIs(oldCode, newCode)
+	require.NoError(t, err)
+	assert.Equal(t, []apiChange{
+		{Kind: changeTypeKindChanged, Symbol: "Alias", Old: "= Pet", New: "Pet"},
+		{Kind: changeMethodRemoved, Symbol: "ClientInterface.DeletePet", Old: "func(context.Context, string) (*http.Response, error)"},
+		{Kind: changeMethodChanged, Symbol: "ClientInterface.ListPets", Old: "func(context.Context, *int) (*http.Response, error)", New: "func(context.Context, int) (*http.Response, error)"},
+		{Kind: changeInterfaceMethodAdded, Symbol: "ClientInterface.UpdatePet", New: "func(context.Context, string) (*http.Response, error)"},
+		{Kind: changeTypeChanged, Symbol: "Kind", Old: "string", New: "int"},
+		{Kind: changeConstantValueChanged, Symbol: "KindCat", Old: `"cat"`, New: `"kitty"`},
+		{Kind: changeConstantRemoved, Symbol: "KindDog", Old: `"dog"`},
+		{Kind: changeConstantTypeChanged, Symbol: "Limit", New: "int"},
+		{Kind: changeTypeRenamed, Symbol: "Owner", Old: "Owner", New: "PetOwner"},
+		{Kind: changeFieldTypeChanged, Symbol: "Pet.Age", Old: "int", New: "int64"},
+		{Kind: changeMethodChanged, Symbol: "Pet.Describe", Old: "(Pet) func(bool) string", New: "(*Pet) func(bool) string"},
+		{Kind: changeFieldPointerChanged, Symbol: "Pet.Name", Old: "*string", New: "string"},
+		{Kind: changeVariableRemoved, Symbol: "Swagger", Old: "[]string"},
+	}, changes)
+
+	changes, err = compareAPIs(oldCode, oldCode)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+const breakingOldSpec = `
+openapi: 3.0.3
+info: {title: pets, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items: {$ref: '#/components/schemas/Pet'}
+  /pets/{id}:
+    delete:
+      operationId: deletePet
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+      responses:
+        '204': {description: deleted}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name: {type: string}
+        kind: {$ref: '#/components/schemas/Kind'}
+    Cat: {$ref: '#/components/schemas/Pet'}
+    Kind:
+      type: string
+      enum: [cat, dog]
+`
+
+const breakingNewSpec = `
+openapi: 3.0.3
+info: {title: pets, version: 2.0.0}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items: {$ref: '#/components/schemas/Pet'}
+components:
+  schemas:
+    Pet:
+      type: object
+      x-go-name: Animal
+      required: [name]
+      properties:
+        name: {type: string}
+        kind: {$ref: '#/components/schemas/Kind'}
+    Kind:
+      type: string
+      enum: [cat, bird]
+`
+
+func TestBreakingChanges(t *testing.T) {
+	dir := t.TempDir()
+	oldSpec := filepath.Join(dir, "old.yaml")
+	newSpec := filepath.Join(dir, "new.yaml")
+	require.NoError(t, os.WriteFile(oldSpec, []byte(breakingOldSpec), 0o644))
+	require.NoError(t, os.WriteFile(newSpec, []byte(breakingNewSpec), 0o644))
+
+	cfg := codegen.Configuration{
+		PackageName: "pets",
+		Generate: codegen.GenerateOptions{
+			Models:    true,
+			Client:    true,
+			ChiServer: true,
+		},
+		OutputOptions: codegen.OutputOptions{SkipPrune: true},
+	}
+	changes, err := breakingChanges(oldSpec, cfg, newSpec, cfg)
+	require.NoError(t, err)
+
+	found := map[string]apiChange{}
+	for _, change := range changes {
+		found[change.Symbol] = change
+	}
+	assert.Equal(t, apiChange{Kind: changeTypeRemoved, Symbol: "Pet", Old: "struct"}, found["Pet"])
+	assert.Equal(t, apiChange{
+		Kind:   changeFieldTypeChanged,
+		Symbol: "ListPetsResponse.JSON200",
+		Old:    "*[]Pet",
+		New:    "*[]Animal",
+	}, found["ListPetsResponse.JSON200"])
+	assert.Equal(t, changeMethodRemoved, found["ClientInterface.DeletePet"].Kind)
+	assert.Equal(t, changeMethodRemoved, found["ClientWithResponsesInterface.DeletePetWithResponse"].Kind)
+	assert.Equal(t, changeMethodRemoved, found["ServerInterface.DeletePet"].Kind)
+	assert.Equal(t, changeFunctionRemoved, found["NewDeletePetRequest"].Kind)
+	assert.Equal(t, changeConstantRemoved, found["KindDog"].Kind)
+	// Additions which don't break implementations aren't reported.
+	assert.NotContains(t, found, "Animal")
+	assert.NotContains(t, found, "KindBird")
+
+	// The old configuration may differ, such as when upgrading from the old
+	// aliasing of types.
+	oldCfg := cfg
+	oldCfg.Compatibility.OldAliasing = true
+	changes, err = breakingChanges(oldSpec, oldCfg, oldSpec, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []apiChange{
+		{Kind: changeTypeKindChanged, Symbol: "Cat", Old: "Pet", New: "= Pet"},
+	}, changes)
+}
@@ -0,0 +1,318 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// generatedHeaderRE matches the generated code notice in the header of
+// generated files, capturing the version of the generator.
+var generatedHeaderRE = regexp.MustCompile(`^// Code generated by \S+ version (.+) DO NOT EDIT\.$`)
+
+// inputHashPrefix starts the line of the header recording the input hash.
+const inputHashPrefix = "// Input hash: "
+
+// staleError reports generated files which aren't up to date with their spec
+// and configuration, along with the differences between them and the code
+// generated now.
+type staleError struct {
+	Files []string
+	Diff  string
+}
+
+func (e *staleError) Error() string {
+	return fmt.Sprintf("generated code is out of date: %s", strings.Join(e.Files, ", "))
+}
+
+// inputHash returns the hash of the inputs of a generation: the spec at
+// specPath, the files it references, the user templates and the
+// configuration. It returns an empty string when the spec, one of the files
+// it references, or one of the user templates, is remote, as those can't be
+// hashed without fetching them.
+func inputHash(specPath string, cfg codegen.Configuration) (string, error) {
+	if isURL(specPath) {
+		return "", nil
+	}
+
+	h := sha256.New()
+	seen := map[string]bool{}
+	var hashFile func(p string) (bool, error)
+	hashFile = func(p string) (bool, error) {
+		absPath, err := filepath.Abs(p)
+		if err != nil {
+			return false, err
+		}
+		if seen[absPath] {
+			return true, nil
+		}
+		seen[absPath] = true
+
+		buf, err := os.ReadFile(p)
+		if err != nil {
+			return false, fmt.Errorf("error reading '%s': %w", p, err)
+		}
+		_, _ = fmt.Fprintf(h, "%d\n", len(buf))
+		_, _ = h.Write(buf)
+
+		refs, err := externalRefs(p)
+		if err != nil {
+			return false, err
+		}
+		for _, ref := range refs {
+			if isURL(ref) {
+				return false, nil
+			}
+			ok, err := hashFile(filepath.Join(filepath.Dir(p), ref))
+			if !ok || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	ok, err := hashFile(specPath)
+	if !ok || err != nil {
+		return "", err
+	}
+
+	// User templates given inline are covered by the configuration, while
+	// those given as files are hashed with their contents. Like
+	// codegen.GetUserTemplateText, anything else is taken for a URL.
+	names := make([]string, 0, len(cfg.OutputOptions.UserTemplates))
+	for name := range cfg.OutputOptions.UserTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tmpl := cfg.OutputOptions.UserTemplates[name]
+		if strings.Contains(tmpl, "\n") {
+			continue
+		}
+		buf, err := os.ReadFile(tmpl)
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("error reading user template '%s': %w", tmpl, err)
+		}
+		_, _ = fmt.Fprintf(h, "%s\n%d\n", name, len(buf))
+		_, _ = h.Write(buf)
+	}
+
+	config, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling configuration: %w", err)
+	}
+	_, _ = h.Write(config)
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// generatorVersion returns the version of this generator, as recorded in the
+// header of generated files. It returns an empty string for development
+// builds, whose version doesn't identify the code they generate.
+func generatorVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok || bi.Main.Version == "" || bi.Main.Version == "(devel)" {
+		return ""
+	}
+	return bi.Main.Version
+}
+
+// readHeader returns the generator version and the input hash recorded in the
+// header of generated code, or empty strings for those it doesn't record.
+func readHeader(code []byte) (version, hash string) {
+	scanner := bufio.NewScanner(bytes.NewReader(code))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+		if match := generatedHeaderRE.FindStringSubmatch(line); match != nil {
+			version = match[1]
+		} else if strings.HasPrefix(line, inputHashPrefix) {
+			hash = strings.TrimPrefix(line, inputHashPrefix)
+		}
+	}
+	return version, hash
+}
+
+// upToDate returns whether the header of generated code shows it was generated
+// from inputs with the given hash, by this very version of the generator.
+func upToDate(code []byte, version, hash string) bool {
+	if version == "" || hash == "" {
+		return false
+	}
+	codeVersion, codeHash := readHeader(code)
+	return codeVersion == version && codeHash == hash
+}
+
+// check checks that the output configured in opts is what would be generated
+// for the spec at specPath, without writing it. When it isn't, it returns a
+// *staleError with the differences, including the files of an output
+// directory which aren't generated anymore. An output file, or the files of
+// an output directory, generated by the same released version of the
+// generator, from inputs with the same hash, are up to date without
+// generating them again.
+func check(specPath string, opts configuration) error {
+	if opts.OutputFile == "" && opts.OutputDir == "" {
+		return errors.New("checking generated code requires an output file or directory")
+	}
+
+	if opts.OutputFile != "" {
+		existing, err := readExisting(opts.OutputFile)
+		if err != nil {
+			return err
+		}
+		if upToDate(existing, generatorVersion(), opts.InputHash) {
+			return nil
+		}
+
+		swagger, err := util.LoadSwagger(specPath)
+		if err != nil {
+			return fmt.Errorf("error loading swagger spec in %s: %w", specPath, err)
+		}
+		code, err := codegen.Generate(swagger, opts.Configuration)
+		if err != nil {
+			return fmt.Errorf("error generating code: %w", err)
+		}
+		return compareGenerated(map[string][]byte{opts.OutputFile: existing}, map[string]string{opts.OutputFile: code})
+	}
+
+	ok, err := dirUpToDate(opts.OutputDir, generatorVersion(), opts.InputHash)
+	if ok || err != nil {
+		return err
+	}
+
+	swagger, err := util.LoadSwagger(specPath)
+	if err != nil {
+		return fmt.Errorf("error loading swagger spec in %s: %w", specPath, err)
+	}
+	files, err := codegen.GenerateFiles(swagger, opts.Configuration)
+	if err != nil {
+		return fmt.Errorf("error generating code: %w", err)
+	}
+	generated := make(map[string]string, len(files))
+	existing := make(map[string][]byte, len(files))
+	for name, code := range files {
+		filePath := filepath.Join(opts.OutputDir, name)
+		generated[filePath] = code
+		if existing[filePath], err = readExisting(filePath); err != nil {
+			return err
+		}
+	}
+	// The files which aren't generated anymore are compared with nothing,
+	// since generating the code removes them.
+	stale, err := staleOutputFiles(opts.OutputDir, files)
+	if err != nil {
+		return fmt.Errorf("error listing generated code in '%s': %w", opts.OutputDir, err)
+	}
+	for _, filePath := range stale {
+		generated[filePath] = ""
+		if existing[filePath], err = readExisting(filePath); err != nil {
+			return err
+		}
+	}
+	return compareGenerated(existing, generated)
+}
+
+// dirUpToDate returns whether the files generated into dir, as told by their
+// header, all show they were generated from inputs with the given hash, by
+// this very version of the generator. It returns false when there are none.
+func dirUpToDate(dir, version, hash string) (bool, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.gen.go"))
+	if err != nil {
+		return false, err
+	}
+	found := false
+	for _, filePath := range matches {
+		code, err := readExisting(filePath)
+		if err != nil {
+			return false, err
+		}
+		if codeVersion, _ := readHeader(code); codeVersion == "" {
+			continue
+		}
+		if !upToDate(code, version, hash) {
+			return false, nil
+		}
+		found = true
+	}
+	return found, nil
+}
+
+// readExisting reads a generated file, which is empty when it doesn't exist
+// yet.
+func readExisting(filePath string) ([]byte, error) {
+	buf, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading generated code in '%s': %w", filePath, err)
+	}
+	return buf, nil
+}
+
+// compareGenerated compares the existing generated files with the generated
+// code, both by file path, and returns a *staleError with a unified diff of
+// those which differ.
+func compareGenerated(existing map[string][]byte, generated map[string]string) error {
+	filePaths := make([]string, 0, len(generated))
+	for filePath := range generated {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	stale := &staleError{}
+	for _, filePath := range filePaths {
+		if string(existing[filePath]) == generated[filePath] {
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(existing[filePath])),
+			B:        difflib.SplitLines(generated[filePath]),
+			FromFile: filePath,
+			ToFile:   filePath + " (generated)",
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("error comparing generated code in '%s': %w", filePath, err)
+		}
+		stale.Files = append(stale.Files, filePath)
+		stale.Diff += diff
+	}
+	if len(stale.Files) > 0 {
+		return stale
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+)
+
+const checkSpec = `
+openapi: 3.0.3
+info: {title: check, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Pet:
+      $ref: 'pet.yaml#/Pet'
+`
+
+const checkPetSpec = `
+Pet:
+  type: object
+  properties:
+    name: {type: string}
+`
+
+const checkInlineSpec = `
+openapi: 3.0.3
+info: {title: check, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name: {type: string}
+`
+
+func TestInputHash(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(checkSpec), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pet.yaml"), []byte(checkPetSpec), 0o644))
+	cfg := codegen.Configuration{PackageName: "check", Generate: codegen.GenerateOptions{Models: true}}
+
+	hash, err := inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "sha256:"))
+
+	again, err := inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	// The hash covers the configuration, but not itself.
+	cfg.InputHash = hash
+	again, err = inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	cfg.PackageName = "other"
+	again, err = inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again)
+	cfg.PackageName = "check"
+
+	// It covers the files referenced by the spec.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pet.yaml"), []byte(checkPetSpec+"    age: {type: integer}\n"), 0o644))
+	again, err = inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again)
+
+	// It covers the contents of the user templates given as files.
+	hash = again
+	templatePath := filepath.Join(dir, "typedef.tmpl")
+	require.NoError(t, os.WriteFile(templatePath, []byte("// one"), 0o644))
+	cfg.OutputOptions.UserTemplates = map[string]string{"typedef.tmpl": templatePath}
+	withTemplate, err := inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, withTemplate)
+	require.NoError(t, os.WriteFile(templatePath, []byte("// two"), 0o644))
+	again, err = inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, withTemplate, again)
+
+	// Remote inputs aren't hashed.
+	cfg.OutputOptions.UserTemplates = map[string]string{"typedef.tmpl": "https://example.com/typedef.tmpl"}
+	hash, err = inputHash(specPath, cfg)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+	cfg.OutputOptions.UserTemplates = nil
+
+	hash, err = inputHash("https://example.com/spec.yaml", cfg)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(checkInlineSpec), 0o644))
+
+	opts := configuration{
+		Configuration: codegen.Configuration{
+			PackageName:   "check",
+			Generate:      codegen.GenerateOptions{Models: true},
+			OutputOptions: codegen.OutputOptions{SkipPrune: true},
+		},
+		OutputFile: filepath.Join(dir, "check.gen.go"),
+	}
+
+	// The output doesn't exist yet.
+	err := check(specPath, opts)
+	var stale *staleError
+	require.True(t, errors.As(err, &stale))
+	assert.Equal(t, []string{opts.OutputFile}, stale.Files)
+
+	require.NoError(t, generate(specPath, opts))
+	code, err := os.ReadFile(opts.OutputFile)
+	require.NoError(t, err)
+	_, hash := readHeader(code)
+	assert.True(t, strings.HasPrefix(hash, "sha256:"))
+
+	opts.InputHash = hash
+	assert.NoError(t, check(specPath, opts))
+
+	// Edits of the generated code are reported with a unified diff.
+	edited := strings.Replace(string(code), "Name *string", "Name string", 1)
+	require.NoError(t, os.WriteFile(opts.OutputFile, []byte(edited), 0o644))
+	err = check(specPath, opts)
+	require.True(t, errors.As(err, &stale))
+	assert.Contains(t, stale.Diff, "--- "+opts.OutputFile+"\n")
+	assert.Contains(t, stale.Diff, "+++ "+opts.OutputFile+" (generated)\n")
+	assert.Contains(t, stale.Diff, "-\tName string ")
+	assert.Contains(t, stale.Diff, "+\tName *string ")
+
+	// So are those of the spec.
+	require.NoError(t, os.WriteFile(opts.OutputFile, code, 0o644))
+	// The loader caches the specs it reads by path, so the changed spec is
+	// written to another one.
+	specPath = filepath.Join(dir, "changed.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(checkInlineSpec+"        age: {type: integer}\n"), 0o644))
+	opts.InputHash, err = inputHash(specPath, opts.Configuration)
+	require.NoError(t, err)
+	err = check(specPath, opts)
+	require.True(t, errors.As(err, &stale))
+	assert.Contains(t, stale.Diff, "+\tAge ")
+
+	// Output directories are checked file by file.
+	opts.OutputFile = ""
+	opts.OutputDir = filepath.Join(dir, "api")
+	require.NoError(t, generate(specPath, opts))
+	assert.NoError(t, check(specPath, opts))
+
+	// Including those which aren't generated anymore.
+	extraPath := filepath.Join(opts.OutputDir, codegen.ChiServerFileName)
+	require.NoError(t, os.WriteFile(extraPath, code, 0o644))
+	err = check(specPath, opts)
+	require.True(t, errors.As(err, &stale))
+	assert.Equal(t, []string{extraPath}, stale.Files)
+	assert.Contains(t, stale.Diff, "--- "+extraPath+"\n")
+	require.NoError(t, generate(specPath, opts))
+	assert.NoError(t, check(specPath, opts))
+}
+
+func TestUpToDate(t *testing.T) {
+	code := []byte("// Code generated by github.com/deepmap/oapi-codegen version v1.2.3 DO NOT EDIT.\n" +
+		"// Input hash: sha256:abc\n\npackage api\n")
+
+	version, hash := readHeader(code)
+	assert.Equal(t, "v1.2.3", version)
+	assert.Equal(t, "sha256:abc", hash)
+
+	assert.True(t, upToDate(code, "v1.2.3", "sha256:abc"))
+	assert.False(t, upToDate(code, "v1.2.4", "sha256:abc"))
+	assert.False(t, upToDate(code, "v1.2.3", "sha256:def"))
+	assert.False(t, upToDate(code, "", "sha256:abc"))
+	assert.False(t, upToDate(code, "v1.2.3", ""))
+}
+
+func TestDirUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	code := []byte("// Code generated by github.com/deepmap/oapi-codegen version v1.2.3 DO NOT EDIT.\n" +
+		"// Input hash: sha256:abc\n\npackage api\n")
+
+	ok, err := dirUpToDate(dir, "v1.2.3", "sha256:abc")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "types.gen.go"), code, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.gen.go"), code, 0o644))
+	// Files generated by other tools don't matter.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mocks.gen.go"), []byte("package api\n"), 0o644))
+	ok, err = dirUpToDate(dir, "v1.2.3", "sha256:abc")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Every file is checked.
+	older := strings.Replace(string(code), "v1.2.3", "v1.2.2", 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.gen.go"), []byte(older), 0o644))
+	ok, err = dirUpToDate(dir, "v1.2.3", "sha256:abc")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -14,6 +14,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -36,6 +37,7 @@ func errExit(format string, args ...interface{}) {
 
 var (
 	flagOutputFile     string
+	flagOutputDir      string
 	flagConfigFile     string
 	flagOldConfigStyle bool
 	flagOutputConfig   bool
@@ -44,6 +46,7 @@ var (
 	flagPrintUsage     bool
 	flagGenerate       string
 	flagTemplatesDir   string
+	flagCheck          bool
 
 	// Deprecated: The options below will be removed in a future
 	// release. Please use the new config file format.
@@ -61,6 +64,11 @@ type configuration struct {
 
 	// OutputFile is the filename to output.
 	OutputFile string `yaml:"output,omitempty"`
+
+	// OutputDir is the directory to output to. When set, the generated code is
+	// split by concern into one file per model, client, server, etc. rather
+	// than written to a single OutputFile.
+	OutputDir string `yaml:"output-dir,omitempty"`
 }
 
 // oldConfiguration is deprecated. Please add no more flags here. It is here
@@ -79,7 +87,14 @@ type oldConfiguration struct {
 }
 
 func main() {
+	// Subcommands have flags of their own, so they are run before parsing
+	// those of the generator.
+	if len(os.Args) > 1 && os.Args[1] == breakingChangesSubcommand {
+		os.Exit(runBreakingChanges(os.Args[2:]))
+	}
+
 	flag.StringVar(&flagOutputFile, "o", "", "Where to output generated code, stdout is default.")
+	flag.StringVar(&flagOutputDir, "output-dir", "", "Directory to output generated code to, split into one file per concern.")
 	flag.BoolVar(&flagOldConfigStyle, "old-config-style", false, "Whether to use the older style config file format.")
 	flag.BoolVar(&flagOutputConfig, "output-config", false, "When true, outputs a configuration file for oapi-codegen using current settings.")
 	flag.StringVar(&flagConfigFile, "config", "", "A YAML config file that controls oapi-codegen behavior.")
@@ -87,6 +102,7 @@ func main() {
 	flag.StringVar(&flagPackageName, "package", "", "The package name for generated code.")
 	flag.BoolVar(&flagPrintUsage, "help", false, "Show this help and exit.")
 	flag.BoolVar(&flagPrintUsage, "h", false, "Same as -help.")
+	flag.BoolVar(&flagCheck, "check", false, "Check that the generated code is up to date, printing a diff and exiting with an error when it isn't, instead of writing it.")
 
 	// All flags below are deprecated, and will be removed in a future release. Please do not
 	// update their behavior.
@@ -119,6 +135,29 @@ func main() {
 		return
 	}
 
+	// A batch configuration lists the specs to generate itself, and is run
+	// on its own.
+	if flagConfigFile != "" {
+		batch, err := loadBatchConfiguration(flagConfigFile)
+		if err != nil {
+			errExit("%v\n", err)
+		}
+		if batch != nil {
+			if flag.NArg() > 0 {
+				errExit("A batch config file lists its own OpenAPI 3.0 spec files, so none may be given as CLI argument\n")
+			}
+			errs := runBatch(flagConfigFile, *batch)
+			for _, err := range errs {
+				printStaleDiff(err)
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+			}
+			if len(errs) > 0 {
+				errExit("%d of %d jobs failed\n", len(errs), len(batch.Jobs))
+			}
+			return
+		}
+	}
+
 	if flag.NArg() < 1 {
 		errExit("Please specify a path to a OpenAPI 3.0 spec file\n")
 	} else if flag.NArg() > 1 {
@@ -214,6 +253,7 @@ func main() {
 					},
 				},
 				OutputFile: flagOutputFile,
+				OutputDir:  flagOutputDir,
 			}
 		}
 
@@ -247,6 +287,9 @@ func main() {
 	if err := opts.Validate(); err != nil {
 		errExit("configuration error: %v\n", err)
 	}
+	if opts.OutputFile != "" && opts.OutputDir != "" {
+		errExit("configuration error: only one of output and output-dir may be specified\n")
+	}
 
 	// If the user asked to output configuration, output it to stdout and exit
 	if flagOutputConfig {
@@ -258,24 +301,125 @@ func main() {
 		return
 	}
 
-	swagger, err := util.LoadSwagger(flag.Arg(0))
+	if err := generate(flag.Arg(0), opts); err != nil {
+		printStaleDiff(err)
+		errExit("%s\n", err)
+	}
+}
+
+// printStaleDiff prints the differences reported by err to stdout, when it
+// reports generated code which isn't up to date.
+func printStaleDiff(err error) {
+	var stale *staleError
+	if errors.As(err, &stale) {
+		fmt.Print(stale.Diff)
+	}
+}
+
+// generate generates the code for the spec at specPath, and writes it to the
+// output configured in opts. With -check, it checks that output instead.
+func generate(specPath string, opts configuration) error {
+	hash, err := inputHash(specPath, opts.Configuration)
 	if err != nil {
-		errExit("error loading swagger spec in %s\n: %s", flag.Arg(0), err)
+		return fmt.Errorf("error hashing the inputs of %s: %w", specPath, err)
+	}
+	opts.InputHash = hash
+
+	if flagCheck {
+		return check(specPath, opts)
 	}
 
-	code, err := codegen.Generate(swagger, opts.Configuration)
+	swagger, err := util.LoadSwagger(specPath)
 	if err != nil {
-		errExit("error generating code: %s\n", err)
+		return fmt.Errorf("error loading swagger spec in %s: %w", specPath, err)
 	}
 
+	g := codegen.NewGenerator(opts.Configuration)
+	if opts.OutputDir != "" {
+		files, err := g.GenerateFiles(swagger)
+		if err != nil {
+			return fmt.Errorf("error generating code: %w", err)
+		}
+		printWarnings(specPath, g)
+		if err := writeOutputFiles(opts.OutputDir, files); err != nil {
+			return fmt.Errorf("error writing generated code to directory: %w", err)
+		}
+		return nil
+	}
+
+	code, err := g.Generate(swagger)
+	if err != nil {
+		return fmt.Errorf("error generating code: %w", err)
+	}
+	printWarnings(specPath, g)
+
 	if opts.OutputFile != "" {
 		err = os.WriteFile(opts.OutputFile, []byte(code), 0o644)
 		if err != nil {
-			errExit("error writing generated code to file: %s\n", err)
+			return fmt.Errorf("error writing generated code to file: %w", err)
 		}
 	} else {
 		fmt.Print(code)
 	}
+	return nil
+}
+
+// printWarnings prints the warnings of the generation of the spec at specPath
+// to stderr.
+func printWarnings(specPath string, g *codegen.Generator) {
+	for _, warning := range g.Warnings() {
+		fmt.Fprintf(os.Stderr, "WARNING: %s: %s\n", specPath, warning)
+	}
+}
+
+// writeOutputFiles writes each generated file into dir, creating dir if it
+// doesn't exist yet, and removes the files generated into dir before which
+// aren't generated anymore.
+func writeOutputFiles(dir string, files map[string]string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, code := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(code), 0o644); err != nil {
+			return err
+		}
+	}
+	stale, err := staleOutputFiles(dir, files)
+	if err != nil {
+		return err
+	}
+	for _, filePath := range stale {
+		if err := os.Remove(filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// staleOutputFiles returns the paths of the *.gen.go files of dir which aren't
+// among the generated files, such as those of a server which isn't generated
+// anymore. Only files whose header shows they were generated by oapi-codegen
+// are returned, so that those of other tools are left alone.
+func staleOutputFiles(dir string, files map[string]string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.gen.go"))
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	for _, filePath := range matches {
+		if _, ok := files[filepath.Base(filePath)]; ok {
+			continue
+		}
+		code, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if version, _ := readHeader(code); version == "" {
+			continue
+		}
+		stale = append(stale, filePath)
+	}
+	return stale, nil
 }
 
 func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
@@ -344,12 +488,18 @@ func detectPackageName(cfg *configuration) error {
 	}
 
 	// Fallback to determining from the spec file name.
-	parts := strings.Split(filepath.Base(flag.Arg(0)), ".")
-	cfg.PackageName = codegen.LowercaseFirstCharacter(codegen.ToCamelCase(parts[0]))
+	cfg.PackageName = packageNameFromSpec(flag.Arg(0))
 
 	return nil
 }
 
+// packageNameFromSpec returns a package name based on the file name of the
+// spec at specPath.
+func packageNameFromSpec(specPath string) string {
+	parts := strings.Split(filepath.Base(specPath), ".")
+	return codegen.LowercaseFirstCharacter(codegen.ToCamelCase(parts[0]))
+}
+
 // updateConfigFromFlags updates a loaded configuration from flags. Flags
 // override anything in the file. We generate errors for any unsupported
 // command line flags.
@@ -397,6 +547,9 @@ func updateConfigFromFlags(cfg *configuration) error {
 	if cfg.OutputFile == "" {
 		cfg.OutputFile = flagOutputFile
 	}
+	if cfg.OutputDir == "" {
+		cfg.OutputDir = flagOutputDir
+	}
 
 	cfg.OutputOptions.InitialismOverrides = flagInitalismOverrides
 
@@ -453,8 +606,12 @@ func generationTargets(cfg *codegen.Configuration, targets []string) error {
 			opts.GinServer = true
 		case "gorilla", "gorilla-server":
 			opts.GorillaServer = true
+		case "std-http", "std-http-server":
+			opts.StdHTTPServer = true
 		case "strict-server":
 			opts.Strict = true
+		case "mock-server":
+			opts.MockServer = true
 		case "client":
 			opts.Client = true
 		case "types", "models":
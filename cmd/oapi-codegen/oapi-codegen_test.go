@@ -1,8 +1,13 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/deepmap/oapi-codegen/pkg/util"
 )
 
@@ -24,3 +29,27 @@ func TestLoader(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	const header = "// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.\n\npackage api\n"
+	require.NoError(t, writeOutputFiles(dir, map[string]string{
+		"types.gen.go":      header,
+		"chi-server.gen.go": header,
+	}))
+	// Generated by another tool.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mocks.gen.go"), []byte("package api\n"), 0o644))
+
+	// The files which aren't generated anymore are removed.
+	require.NoError(t, writeOutputFiles(dir, map[string]string{
+		"types.gen.go":       header,
+		"echo-server.gen.go": header,
+	}))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"echo-server.gen.go", "mocks.gen.go", "types.gen.go"}, names)
+}
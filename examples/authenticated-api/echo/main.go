@@ -13,12 +13,36 @@ import (
 
 func main() {
 	port := flag.String("port", "8080", "port where to serve traffic")
+	jwksFile := flag.String("jwks", "", "JWKS file with the keys to issue tokens with, instead of a hard coded key")
+	rotateKey := flag.Bool("rotate-key", false, "add a new key to the JWKS file, keeping the previous one, and exit")
+	flag.Parse()
+
+	if *rotateKey {
+		if *jwksFile == "" {
+			log.Fatalln("-rotate-key requires -jwks")
+		}
+		keyID, err := server.RotateJWKSFile(*jwksFile, 2)
+		if err != nil {
+			log.Fatalln("error rotating keys:", err)
+		}
+		log.Println("Rotated to key", keyID)
+		return
+	}
 
 	e := echo.New()
 
 	// Create a fake authenticator. This allows us to issue tokens, and also
 	// implements a validator to check their validity.
-	fa, err := server.NewFakeAuthenticator()
+	var fa interface {
+		server.JWSValidator
+		CreateJWSWithClaims(claims []string) ([]byte, error)
+	}
+	var err error
+	if *jwksFile != "" {
+		fa, err = server.NewJWKSAuthenticator(*jwksFile)
+	} else {
+		fa, err = server.NewFakeAuthenticator()
+	}
 	if err != nil {
 		log.Fatalln("error creating authenticator:", err)
 	}
@@ -92,6 +92,16 @@ func (f *FakeAuthenticator) SignToken(t jwt.Token) ([]byte, error) {
 // CreateJWSWithClaims is a helper function to create JWT's with the specified
 // claims.
 func (f *FakeAuthenticator) CreateJWSWithClaims(claims []string) ([]byte, error) {
+	t, err := newTokenWithClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+	return f.SignToken(t)
+}
+
+// newTokenWithClaims creates a JWT for our issuer and audience, with the
+// specified permission claims.
+func newTokenWithClaims(claims []string) (jwt.Token, error) {
 	t := jwt.New()
 	err := t.Set(jwt.IssuerKey, FakeIssuer)
 	if err != nil {
@@ -105,5 +115,5 @@ func (f *FakeAuthenticator) CreateJWSWithClaims(claims []string) ([]byte, error)
 	if err != nil {
 		return nil, fmt.Errorf("setting permissions: %w", err)
 	}
-	return f.SignToken(t)
+	return t, nil
 }
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/deepmap/oapi-codegen/pkg/ecdsafile"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// JWKSAuthenticator is an authenticator like FakeAuthenticator, whose keys are
+// read from a local JWKS file rather than hard coded. The file is read again
+// whenever it changes, so that keys can be rotated while the server runs.
+// Tokens are signed with the last key of the file, and validated with any of
+// its keys, so tokens signed before a rotation stay valid until their key is
+// removed from the file.
+type JWKSAuthenticator struct {
+	path string
+
+	mu         sync.Mutex
+	info       fs.FileInfo
+	signingKey ecdsafile.JWK
+	keySet     jwk.Set
+}
+
+var _ JWSValidator = (*JWKSAuthenticator)(nil)
+
+// NewJWKSAuthenticator creates an authenticator with the keys of a JWKS file,
+// as written by RotateJWKSFile.
+func NewJWKSAuthenticator(path string) (*JWKSAuthenticator, error) {
+	a := &JWKSAuthenticator{path: path}
+	if _, _, err := a.keys(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// keys returns the key to sign tokens with, and the set of keys to validate
+// tokens with, reading the JWKS file again when it changed.
+func (a *JWKSAuthenticator) keys() (ecdsafile.JWK, jwk.Set, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return ecdsafile.JWK{}, nil, fmt.Errorf("reading JWKS file: %w", err)
+	}
+	// Files replaced by RotateJWKSFile aren't the same file anymore, even when
+	// their modification time is within the resolution of the file system.
+	if a.info != nil && os.SameFile(info, a.info) && info.ModTime().Equal(a.info.ModTime()) && info.Size() == a.info.Size() {
+		return a.signingKey, a.keySet, nil
+	}
+
+	buf, err := os.ReadFile(a.path)
+	if err != nil {
+		return ecdsafile.JWK{}, nil, fmt.Errorf("reading JWKS file: %w", err)
+	}
+	set, err := ecdsafile.LoadJWKSet(buf)
+	if err != nil {
+		return ecdsafile.JWK{}, nil, err
+	}
+	if len(set.Keys) == 0 {
+		return ecdsafile.JWK{}, nil, fmt.Errorf("JWKS file %s has no keys", a.path)
+	}
+	signingKey := set.Keys[len(set.Keys)-1]
+	if !signingKey.IsPrivate() {
+		return ecdsafile.JWK{}, nil, fmt.Errorf("key %q of JWKS file %s isn't a private key", signingKey.KeyID, a.path)
+	}
+
+	// Only the public keys are needed for validation, which we hand over to
+	// jwx in the form they would be published in.
+	publicKeys, err := ecdsafile.StoreJWKSet(set)
+	if err != nil {
+		return ecdsafile.JWK{}, nil, err
+	}
+	keySet, err := jwk.Parse(publicKeys)
+	if err != nil {
+		return ecdsafile.JWK{}, nil, fmt.Errorf("parsing jwk set: %w", err)
+	}
+
+	a.info, a.signingKey, a.keySet = info, signingKey, keySet
+	return signingKey, keySet, nil
+}
+
+// ValidateJWS ensures that the critical JWT claims needed to ensure that we
+// trust the JWT are present and with the correct values.
+func (a *JWKSAuthenticator) ValidateJWS(jwsString string) (jwt.Token, error) {
+	_, keySet, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	return jwt.Parse([]byte(jwsString), jwt.WithKeySet(keySet),
+		jwt.WithAudience(FakeAudience), jwt.WithIssuer(FakeIssuer))
+}
+
+// SignToken takes a JWT and signs it with the current key, returning a JWS.
+func (a *JWKSAuthenticator) SignToken(t jwt.Token) ([]byte, error) {
+	signingKey, _, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	alg := jwa.SignatureAlgorithm(signingKey.Algorithm)
+	hdr := jws.NewHeaders()
+	if err := hdr.Set(jws.AlgorithmKey, alg); err != nil {
+		return nil, fmt.Errorf("setting algorithm: %w", err)
+	}
+	if err := hdr.Set(jws.TypeKey, "JWT"); err != nil {
+		return nil, fmt.Errorf("setting type: %w", err)
+	}
+	if err := hdr.Set(jws.KeyIDKey, signingKey.KeyID); err != nil {
+		return nil, fmt.Errorf("setting Key ID: %w", err)
+	}
+	return jwt.Sign(t, alg, signingKey.Key, jwt.WithHeaders(hdr))
+}
+
+// CreateJWSWithClaims is a helper function to create JWT's with the specified
+// claims.
+func (a *JWKSAuthenticator) CreateJWSWithClaims(claims []string) ([]byte, error) {
+	t, err := newTokenWithClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+	return a.SignToken(t)
+}
+
+// RotateJWKSFile adds a new ECDSA key to a JWKS file, creating the file when
+// it doesn't exist, and returns its key ID. Authenticators reading the file
+// sign tokens with the new key from then on. Only the last keep keys are kept,
+// when keep is positive, so that tokens signed with older keys aren't valid
+// anymore.
+func RotateJWKSFile(path string, keep int) (string, error) {
+	var set ecdsafile.JWKSet
+	buf, err := os.ReadFile(path)
+	if err == nil {
+		if set, err = ecdsafile.LoadJWKSet(buf); err != nil {
+			return "", err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("reading JWKS file: %w", err)
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("generating key: %w", err)
+	}
+	key, err := ecdsafile.NewJWK(privateKey, "")
+	if err != nil {
+		return "", err
+	}
+	// The thumbprint of the key makes a key ID which is unique to it.
+	if key.KeyID, err = key.Thumbprint(); err != nil {
+		return "", err
+	}
+	set.Keys = append(set.Keys, key)
+	if keep > 0 && len(set.Keys) > keep {
+		set.Keys = set.Keys[len(set.Keys)-keep:]
+	}
+
+	// The file holds the private keys which sign tokens.
+	if buf, err = ecdsafile.StorePrivateJWKSet(set); err != nil {
+		return "", err
+	}
+	// The file is replaced at once, so that authenticators never read it
+	// partially written.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return "", fmt.Errorf("writing JWKS file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(buf); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("writing JWKS file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("writing JWKS file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("writing JWKS file: %w", err)
+	}
+	return key.KeyID, nil
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSAuthenticator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+
+	_, err := NewJWKSAuthenticator(path)
+	assert.Error(t, err)
+
+	firstKeyID, err := RotateJWKSFile(path, 2)
+	require.NoError(t, err)
+	a, err := NewJWKSAuthenticator(path)
+	require.NoError(t, err)
+
+	firstJWS, err := a.CreateJWSWithClaims([]string{"things:w"})
+	require.NoError(t, err)
+	assert.Equal(t, firstKeyID, keyIDOf(t, firstJWS))
+	_, err = a.ValidateJWS(string(firstJWS))
+	require.NoError(t, err)
+
+	// After a rotation, tokens are signed with the new key, and the ones
+	// signed with the previous key are still valid.
+	secondKeyID, err := RotateJWKSFile(path, 2)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstKeyID, secondKeyID)
+	secondJWS, err := a.CreateJWSWithClaims([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, secondKeyID, keyIDOf(t, secondJWS))
+	_, err = a.ValidateJWS(string(firstJWS))
+	assert.NoError(t, err)
+
+	// Once the first key is dropped, its tokens aren't valid anymore.
+	_, err = RotateJWKSFile(path, 2)
+	require.NoError(t, err)
+	_, err = a.ValidateJWS(string(firstJWS))
+	assert.Error(t, err)
+	_, err = a.ValidateJWS(string(secondJWS))
+	assert.NoError(t, err)
+
+	// The JWKS file is only readable by its owner, since it holds private
+	// keys.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func keyIDOf(t *testing.T, jwsBytes []byte) string {
+	msg, err := jws.Parse(jwsBytes)
+	require.NoError(t, err)
+	return msg.Signatures()[0].ProtectedHeaders().KeyID()
+}
@@ -1,6 +1,7 @@
 // Package customclienttype provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:255e77ab7d3a65a8a6ce8b6487cf661500c08ab6e5fd1254d19e9a927422e230
 package customclienttype
 
 import (
@@ -11,6 +12,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 // Client defines model for Client.
@@ -43,6 +46,21 @@ type CustomClientType struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// RetryPolicy, when set, retries the requests of operations which may be
+	// retried: those with idempotent methods, unless overridden by the
+	// x-retryable extension of the operation.
+	RetryPolicy *runtime.RetryPolicy
+
+	// SecurityProviders, when set, apply credentials to the requests of
+	// operations according to their security requirements, by the name of
+	// their security scheme.
+	SecurityProviders map[string]RequestEditorFn
+
+	// RequestSigners, when set, sign the requests of operations whose
+	// security requirements include their security scheme, once the request
+	// editors have been applied.
+	RequestSigners map[string]RequestEditorFn
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -89,6 +107,57 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRetryPolicy allows retrying the requests of operations which failed
+// transiently, such as with runtime.DefaultRetryPolicy().
+func WithRetryPolicy(policy runtime.RetryPolicy) ClientOption {
+	return func(c *CustomClientType) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithSecurityProviders allows applying credentials to the requests of each
+// operation according to its security requirements, with the providers of the
+// security schemes they are made of, such as:
+//
+//	WithSecurityProviders(map[string]RequestEditorFn{
+//		"bearerAuth": bearerProvider.Intercept,
+//		"apiKey":     apiKeyProvider.Intercept,
+//	})
+//
+// The first requirement of an operation whose schemes all have a provider is
+// satisfied, and operations without security requirements, such as those
+// declaring "security: []", are sent without credentials.
+func WithSecurityProviders(providers map[string]RequestEditorFn) ClientOption {
+	return func(c *CustomClientType) error {
+		if c.SecurityProviders == nil {
+			c.SecurityProviders = make(map[string]RequestEditorFn, len(providers))
+		}
+		for name, provider := range providers {
+			c.SecurityProviders[name] = provider
+		}
+		return nil
+	}
+}
+
+// WithRequestSigners allows signing the requests of each operation according
+// to its security requirements, such as with the Intercept method of a
+// securityprovider.SecurityProviderHTTPSignature. Signers satisfy the security
+// schemes they're given for like security providers do, but since signatures
+// cover the request as it is sent, they're applied last, after the security
+// providers and the request editors, including those of the call.
+func WithRequestSigners(signers map[string]RequestEditorFn) ClientOption {
+	return func(c *CustomClientType) error {
+		if c.RequestSigners == nil {
+			c.RequestSigners = make(map[string]RequestEditorFn, len(signers))
+		}
+		for name, signer := range signers {
+			c.RequestSigners[name] = signer
+		}
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetClient request
@@ -101,10 +170,21 @@ func (c *CustomClientType) GetClient(ctx context.Context, reqEditors ...RequestE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
 // NewGetClientRequest generates requests for GetClient
@@ -134,6 +214,60 @@ func NewGetClientRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// do sends req, retrying it according to the retry policy of c when the
+// operation is retryable.
+func (c *CustomClientType) do(req *http.Request, retryable bool) (*http.Response, error) {
+	if c.RetryPolicy == nil {
+		return c.Client.Do(req)
+	}
+	return c.RetryPolicy.Do(c.Client, req, retryable)
+}
+
+// applySecurity applies the credentials of the first of the security
+// requirements of an operation whose security schemes all have a provider in
+// c.SecurityProviders or a signer in c.RequestSigners, and returns the signers
+// of the requirement, which sign the request once it's complete. Requirements
+// whose providers fail are skipped, and no credentials are applied when the
+// operation has no requirements.
+func (c *CustomClientType) applySecurity(ctx context.Context, req *http.Request, requirements [][]string) ([]RequestEditorFn, error) {
+	if (c.SecurityProviders == nil && c.RequestSigners == nil) || len(requirements) == 0 {
+		return nil, nil
+	}
+	var lastErr error
+	for _, schemes := range requirements {
+		providers := make([]RequestEditorFn, 0, len(schemes))
+		var signers []RequestEditorFn
+		for _, scheme := range schemes {
+			if provider, ok := c.SecurityProviders[scheme]; ok {
+				providers = append(providers, provider)
+			} else if signer, ok := c.RequestSigners[scheme]; ok {
+				signers = append(signers, signer)
+			}
+		}
+		if len(providers)+len(signers) != len(schemes) {
+			continue
+		}
+		attempt := req.Clone(ctx)
+		err := func() error {
+			for _, provider := range providers {
+				if err := provider(ctx, attempt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err == nil {
+			*req = *attempt
+			return signers, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("no security providers for the security requirements of %s %s", req.Method, req.URL.Path)
+}
+
 func (c *CustomClientType) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
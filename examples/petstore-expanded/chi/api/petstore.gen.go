@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:736e9b6fe2aeb93cc73b59c9ef03a13608b853823c5647e07a23e5975b95705d
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package models provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:05f109968a2bba8289acf44228cf2fabc8a27b815e2eb577248ba1c864b7cce2
 package models
 
 // Error defines model for Error.
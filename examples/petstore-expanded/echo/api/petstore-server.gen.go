@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:564d3b10cfe65ecedd24386840ea28c50a49affc922e693e9b5fbdd5f6e6eef0
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:1132b048e9c4810de73e7be4de0f156718375a90086966b1d4c674bdbb528a62
 package api
 
 import (
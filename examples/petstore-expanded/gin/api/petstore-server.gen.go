@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:803f5f7acb0f168753a71765e46d7b969af413cc9632e2898c518b6a2e759177
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:1141badc0c96c3738abfdaf04c9903d48aef2d4c9ee69a037575c59fae2134bf
 package api
 
 // Error defines model for Error.
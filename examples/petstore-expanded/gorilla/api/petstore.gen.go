@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:f38955697f80120e26913ac1e51e4b54848c394cef38aca41a9fa9ac49a5f51b
 package api
 
 import (
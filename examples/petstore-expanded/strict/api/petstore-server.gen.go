@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:8f4a4602030c8629cfc328698757f027022a08c077d0e76230279ab92e0fa11e
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package v1 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:b055a5d5e5b3bafa13641c8eafe062ac02908dafae5b17993e2afa32b7d25cd8
 package v1
 
 import (
@@ -1,6 +1,7 @@
 // Package v2 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:64540799c24f2052036fdad844dcb41b77dbaa87a4cd5f570b2656219c98fbbe
 package v2
 
 import (
@@ -0,0 +1,938 @@
+// Package callbacks provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:abcf9e62fd43318619f6c1f9b495b88a225032c541f1a83dce652d94e3f83fac
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// Adoption defines model for Adoption.
+type Adoption struct {
+	Adopter *string `json:"adopter"`
+	PetId   int     `json:"petId"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	Kind string `json:"kind"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	CallbackUrl string `json:"callbackUrl"`
+}
+
+// CreateSubscriptionOnEventParams defines parameters for CreateSubscriptionOnEvent.
+type CreateSubscriptionOnEventParams struct {
+	XEventId string `json:"X-Event-Id"`
+}
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = Subscription
+
+// CreateSubscriptionOnEventJSONRequestBody defines body for CreateSubscriptionOnEvent for application/json ContentType.
+type CreateSubscriptionOnEventJSONRequestBody = Event
+
+// PetAdoptedJSONRequestBody defines body for PetAdopted for application/json ContentType.
+type PetAdoptedJSONRequestBody = Adoption
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// RetryPolicy, when set, retries the requests of operations which may be
+	// retried: those with idempotent methods, unless overridden by the
+	// x-retryable extension of the operation.
+	RetryPolicy *runtime.RetryPolicy
+
+	// SecurityProviders, when set, apply credentials to the requests of
+	// operations according to their security requirements, by the name of
+	// their security scheme.
+	SecurityProviders map[string]RequestEditorFn
+
+	// RequestSigners, when set, sign the requests of operations whose
+	// security requirements include their security scheme, once the request
+	// editors have been applied.
+	RequestSigners map[string]RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRetryPolicy allows retrying the requests of operations which failed
+// transiently, such as with runtime.DefaultRetryPolicy().
+func WithRetryPolicy(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithSecurityProviders allows applying credentials to the requests of each
+// operation according to its security requirements, with the providers of the
+// security schemes they are made of, such as:
+//
+//	WithSecurityProviders(map[string]RequestEditorFn{
+//		"bearerAuth": bearerProvider.Intercept,
+//		"apiKey":     apiKeyProvider.Intercept,
+//	})
+//
+// The first requirement of an operation whose schemes all have a provider is
+// satisfied, and operations without security requirements, such as those
+// declaring "security: []", are sent without credentials.
+func WithSecurityProviders(providers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.SecurityProviders == nil {
+			c.SecurityProviders = make(map[string]RequestEditorFn, len(providers))
+		}
+		for name, provider := range providers {
+			c.SecurityProviders[name] = provider
+		}
+		return nil
+	}
+}
+
+// WithRequestSigners allows signing the requests of each operation according
+// to its security requirements, such as with the Intercept method of a
+// securityprovider.SecurityProviderHTTPSignature. Signers satisfy the security
+// schemes they're given for like security providers do, but since signatures
+// cover the request as it is sent, they're applied last, after the security
+// providers and the request editors, including those of the call.
+func WithRequestSigners(signers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.RequestSigners == nil {
+			c.RequestSigners = make(map[string]RequestEditorFn, len(signers))
+		}
+		for name, signer := range signers {
+			c.RequestSigners[name] = signer
+		}
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CreateSubscriptionWithBody request with any body
+	CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+// NewCreateSubscriptionRequest calls the generic CreateSubscription builder with application/json body
+func NewCreateSubscriptionRequest(server string, body CreateSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSubscriptionRequestWithBody generates requests for CreateSubscription with any type of body
+func NewCreateSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// do sends req, retrying it according to the retry policy of c when the
+// operation is retryable.
+func (c *Client) do(req *http.Request, retryable bool) (*http.Response, error) {
+	if c.RetryPolicy == nil {
+		return c.Client.Do(req)
+	}
+	return c.RetryPolicy.Do(c.Client, req, retryable)
+}
+
+// applySecurity applies the credentials of the first of the security
+// requirements of an operation whose security schemes all have a provider in
+// c.SecurityProviders or a signer in c.RequestSigners, and returns the signers
+// of the requirement, which sign the request once it's complete. Requirements
+// whose providers fail are skipped, and no credentials are applied when the
+// operation has no requirements.
+func (c *Client) applySecurity(ctx context.Context, req *http.Request, requirements [][]string) ([]RequestEditorFn, error) {
+	if (c.SecurityProviders == nil && c.RequestSigners == nil) || len(requirements) == 0 {
+		return nil, nil
+	}
+	var lastErr error
+	for _, schemes := range requirements {
+		providers := make([]RequestEditorFn, 0, len(schemes))
+		var signers []RequestEditorFn
+		for _, scheme := range schemes {
+			if provider, ok := c.SecurityProviders[scheme]; ok {
+				providers = append(providers, provider)
+			} else if signer, ok := c.RequestSigners[scheme]; ok {
+				signers = append(signers, signer)
+			}
+		}
+		if len(providers)+len(signers) != len(schemes) {
+			continue
+		}
+		attempt := req.Clone(ctx)
+		err := func() error {
+			for _, provider := range providers {
+				if err := provider(ctx, attempt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err == nil {
+			*req = *attempt
+			return signers, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("no security providers for the security requirements of %s %s", req.Method, req.URL.Path)
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CallbackClient invokes the callbacks and webhooks of the API, at the URLs
+// given by their subscribers.
+type CallbackClient struct {
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// CallbackClientOption allows setting custom parameters during construction
+type CallbackClientOption func(*CallbackClient) error
+
+// Creates a new CallbackClient, with reasonable defaults
+func NewCallbackClient(opts ...CallbackClientOption) (*CallbackClient, error) {
+	client := CallbackClient{}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithCallbackHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithCallbackHTTPClient(doer HttpRequestDoer) CallbackClientOption {
+	return func(c *CallbackClient) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithCallbackRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithCallbackRequestEditorFn(fn RequestEditorFn) CallbackClientOption {
+	return func(c *CallbackClient) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// CreateSubscriptionOnEventURL evaluates the URL of the onEvent callback of CreateSubscription,
+// {$request.body#/callbackUrl}/events, against the request of CreateSubscription.
+func CreateSubscriptionOnEventURL(source runtime.RuntimeExpressionSource) (string, error) {
+	return runtime.EvaluateRuntimeExpression("{$request.body#/callbackUrl}/events", source)
+}
+
+// CreateSubscriptionOnEventWithBody invokes the onEvent callback at callbackURL with any body
+func (c *CallbackClient) CreateSubscriptionOnEventWithBody(ctx context.Context, callbackURL string, params *CreateSubscriptionOnEventParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionOnEventRequestWithBody(callbackURL, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateSubscriptionOnEvent invokes CreateSubscriptionOnEvent at callbackURL with application/json body
+func (c *CallbackClient) CreateSubscriptionOnEvent(ctx context.Context, callbackURL string, params *CreateSubscriptionOnEventParams, body CreateSubscriptionOnEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionOnEventRequest(callbackURL, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// PetAdoptedWithBody invokes the petAdopted webhook at callbackURL with any body
+func (c *CallbackClient) PetAdoptedWithBody(ctx context.Context, callbackURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPetAdoptedRequestWithBody(callbackURL, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// PetAdopted invokes PetAdopted at callbackURL with application/json body
+func (c *CallbackClient) PetAdopted(ctx context.Context, callbackURL string, body PetAdoptedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPetAdoptedRequest(callbackURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCreateSubscriptionOnEventRequest calls the generic CreateSubscriptionOnEvent builder with application/json body
+func NewCreateSubscriptionOnEventRequest(server string, params *CreateSubscriptionOnEventParams, body CreateSubscriptionOnEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionOnEventRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateSubscriptionOnEventRequestWithBody generates requests for CreateSubscriptionOnEvent with any type of body
+func NewCreateSubscriptionOnEventRequestWithBody(server string, params *CreateSubscriptionOnEventParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	queryURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Event-Id", runtime.ParamLocationHeader, params.XEventId)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Event-Id", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPetAdoptedRequest calls the generic PetAdopted builder with application/json body
+func NewPetAdoptedRequest(server string, body PetAdoptedJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPetAdoptedRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPetAdoptedRequestWithBody generates requests for PetAdopted with any type of body
+func NewPetAdoptedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	queryURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *CallbackClient) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateSubscriptionWithBodyWithResponse request with any body
+	CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+}
+
+type CreateSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateSubscriptionResponse
+func (c *ClientWithResponses) CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+// ParseCreateSubscriptionResponse parses an HTTP response from a CreateSubscriptionWithResponse call
+func ParseCreateSubscriptionResponse(rsp *http.Response) (*CreateSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /subscriptions)
+	CreateSubscription(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (POST /subscriptions)
+func (_ Unimplemented) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CreateSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions", wrapper.CreateSubscription)
+	})
+
+	return r
+}
+
+// CallbackServerInterface represents all server handlers.
+type CallbackServerInterface interface {
+
+	// (POST /events)
+	CreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request, params CreateSubscriptionOnEventParams)
+
+	// (POST /petAdopted)
+	PetAdopted(w http.ResponseWriter, r *http.Request)
+}
+
+// CallbackUnimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type CallbackUnimplemented struct{}
+
+// (POST /events)
+func (_ CallbackUnimplemented) CreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request, params CreateSubscriptionOnEventParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /petAdopted)
+func (_ CallbackUnimplemented) PetAdopted(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CallbackServerInterfaceWrapper converts contexts to parameters.
+type CallbackServerInterfaceWrapper struct {
+	Handler            CallbackServerInterface
+	HandlerMiddlewares []CallbackMiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type CallbackMiddlewareFunc func(http.Handler) http.Handler
+
+// CreateSubscriptionOnEvent operation middleware
+func (siw *CallbackServerInterfaceWrapper) CreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSubscriptionOnEventParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Event-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Event-Id")]; found {
+		var XEventId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Event-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Event-Id", runtime.ParamLocationHeader, valueList[0], &XEventId)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Event-Id", Err: err})
+			return
+		}
+
+		params.XEventId = XEventId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Event-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Event-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSubscriptionOnEvent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PetAdopted operation middleware
+func (siw *CallbackServerInterfaceWrapper) PetAdopted(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PetAdopted(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func CallbackHandler(si CallbackServerInterface) http.Handler {
+	return CallbackHandlerWithOptions(si, ChiCallbackServerOptions{})
+}
+
+type ChiCallbackServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []CallbackMiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func CallbackHandlerFromMux(si CallbackServerInterface, r chi.Router) http.Handler {
+	return CallbackHandlerWithOptions(si, ChiCallbackServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func CallbackHandlerFromMuxWithBaseURL(si CallbackServerInterface, r chi.Router, baseURL string) http.Handler {
+	return CallbackHandlerWithOptions(si, ChiCallbackServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func CallbackHandlerWithOptions(si CallbackServerInterface, options ChiCallbackServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := CallbackServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/events", wrapper.CreateSubscriptionOnEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/petAdopted", wrapper.PetAdopted)
+	})
+
+	return r
+}
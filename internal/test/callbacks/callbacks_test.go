@@ -0,0 +1,91 @@
+package callbacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// provider serves the API, and invokes the callbacks of its subscribers.
+type provider struct {
+	Unimplemented
+	callbacks *CallbackClient
+	t         *testing.T
+}
+
+func (p provider) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var subscription Subscription
+	require.NoError(p.t, json.NewDecoder(r.Body).Decode(&subscription))
+
+	callbackURL, err := CreateSubscriptionOnEventURL(runtime.RuntimeExpressionSource{Request: r, Body: subscription})
+	require.NoError(p.t, err)
+	rsp, err := p.callbacks.CreateSubscriptionOnEvent(r.Context(), callbackURL, &CreateSubscriptionOnEventParams{XEventId: "event-1"}, Event{Kind: "created"})
+	require.NoError(p.t, err)
+	assert.Equal(p.t, http.StatusNoContent, rsp.StatusCode)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// subscriber receives the callbacks and webhooks of the API.
+type subscriber struct {
+	events    []string
+	adoptions []Adoption
+}
+
+func (s *subscriber) CreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request, params CreateSubscriptionOnEventParams) {
+	var event Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.events = append(s.events, params.XEventId+":"+event.Kind)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *subscriber) PetAdopted(w http.ResponseWriter, r *http.Request) {
+	var adoption Adoption
+	if err := json.NewDecoder(r.Body).Decode(&adoption); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.adoptions = append(s.adoptions, adoption)
+}
+
+func TestCallbacks(t *testing.T) {
+	sub := &subscriber{}
+	subscriberServer := httptest.NewServer(CallbackHandlerWithOptions(sub, ChiCallbackServerOptions{BaseURL: "/hooks"}))
+	defer subscriberServer.Close()
+
+	callbacks, err := NewCallbackClient()
+	require.NoError(t, err)
+	providerServer := httptest.NewServer(Handler(provider{callbacks: callbacks, t: t}))
+	defer providerServer.Close()
+
+	client, err := NewClient(providerServer.URL)
+	require.NoError(t, err)
+	rsp, err := client.CreateSubscription(context.Background(), Subscription{CallbackUrl: subscriberServer.URL + "/hooks"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rsp.StatusCode)
+	assert.Equal(t, []string{"event-1:created"}, sub.events)
+}
+
+func TestWebhooks(t *testing.T) {
+	sub := &subscriber{}
+	subscriberServer := httptest.NewServer(CallbackHandler(sub))
+	defer subscriberServer.Close()
+
+	callbacks, err := NewCallbackClient()
+	require.NoError(t, err)
+	rsp, err := callbacks.PetAdopted(context.Background(), subscriberServer.URL+"/petAdopted", Adoption{PetId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	require.Len(t, sub.adoptions, 1)
+	assert.Equal(t, 3, sub.adoptions[0].PetId)
+	assert.Nil(t, sub.adoptions[0].Adopter)
+}
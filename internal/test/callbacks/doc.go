@@ -0,0 +1,3 @@
+package callbacks
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --config=config.yaml spec.yaml
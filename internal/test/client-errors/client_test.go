@@ -0,0 +1,59 @@
+package clienterrors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientResponseErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pets/1":
+			_, _ = w.Write([]byte(`{"name":"Tom"}`))
+		case "/pets/2":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id":2}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"oops"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	response, err := client.GetPetWithResponse(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Tom", response.JSON200.Name)
+
+	_, err = client.GetPetWithResponse(ctx, 2)
+	var getPetErr *GetPetError
+	require.True(t, errors.As(err, &getPetErr))
+	assert.Equal(t, http.StatusNotFound, getPetErr.StatusCode())
+	assert.Equal(t, &NotFound{Id: 2}, getPetErr.JSON404)
+	assert.Nil(t, getPetErr.JSONDefault)
+	assert.Equal(t, &NotFound{Id: 2}, getPetErr.Response.JSON404)
+	assert.EqualError(t, err, "GetPet: unexpected response status 404 Not Found")
+
+	_, err = client.GetPetWithResponse(ctx, 3)
+	require.True(t, errors.As(err, &getPetErr))
+	assert.Equal(t, &Error{Message: "oops"}, getPetErr.JSONDefault)
+	assert.Nil(t, getPetErr.JSON404)
+
+	// Responses without declared bodies.
+	_, err = client.DeletePetWithResponse(ctx, 3)
+	var deletePetErr *DeletePetError
+	require.True(t, errors.As(err, &deletePetErr))
+	assert.Equal(t, http.StatusInternalServerError, deletePetErr.StatusCode())
+	// Undeclared bodies are left raw.
+	assert.JSONEq(t, `{"message":"oops"}`, string(deletePetErr.Response.Body))
+}
@@ -0,0 +1,70 @@
+package clientretry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+func TestClientRetries(t *testing.T) {
+	var attempts int
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := runtime.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	client, err := NewClient(server.URL, WithRetryPolicy(policy))
+	require.NoError(t, err)
+
+	send := func(call func() (*http.Response, error)) int {
+		attempts = 0
+		bodies = nil
+		resp, err := call()
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	ctx := context.Background()
+
+	// Idempotent methods are retried.
+	assert.Equal(t, http.StatusOK, send(func() (*http.Response, error) { return client.ListPets(ctx) }))
+	assert.Equal(t, 2, attempts)
+
+	// Other methods aren't.
+	name := "Tom"
+	assert.Equal(t, http.StatusServiceUnavailable, send(func() (*http.Response, error) { return client.CreatePet(ctx, Pet{Name: &name}) }))
+	assert.Equal(t, 1, attempts)
+
+	// Unless overridden by x-retryable, in which case typed bodies are
+	// sent again.
+	assert.Equal(t, http.StatusOK, send(func() (*http.Response, error) { return client.SearchPets(ctx, Pet{Name: &name}) }))
+	assert.Equal(t, []string{`{"name":"Tom"}`, `{"name":"Tom"}`}, bodies)
+
+	assert.Equal(t, http.StatusServiceUnavailable, send(func() (*http.Response, error) { return client.DeletePet(ctx, 1) }))
+	assert.Equal(t, 1, attempts)
+
+	// Without a retry policy, requests are sent once.
+	client, err = NewClient(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, send(func() (*http.Response, error) { return client.ListPets(ctx) }))
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,131 @@
+package clientsecurity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/ecdsafile"
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
+)
+
+func TestSecurityProviders(t *testing.T) {
+	var last *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	bearer, err := securityprovider.NewSecurityProviderBearerToken("token")
+	require.NoError(t, err)
+	apiKey, err := securityprovider.NewSecurityProviderApiKey("header", "X-API-Key", "key")
+	require.NoError(t, err)
+	appID, err := securityprovider.NewSecurityProviderApiKey("query", "app_id", "app")
+	require.NoError(t, err)
+
+	client, err := NewClient(server.URL, WithSecurityProviders(map[string]RequestEditorFn{
+		"bearerAuth": bearer.Intercept,
+		"apiKey":     apiKey.Intercept,
+		"appId":      appID.Intercept,
+	}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// The requirement of the API.
+	_, err = client.ListPets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", last.Header.Get("Authorization"))
+
+	// The request editors of calls override the credentials.
+	_, err = client.ListPets(ctx, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer other")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer other", last.Header.Get("Authorization"))
+
+	// Public operations.
+	_, err = client.GetHealth(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, last.Header.Get("Authorization"))
+
+	// The first requirement whose schemes all have providers, without
+	// applying the credentials of the others.
+	_, err = client.RunAdminTask(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, last.Header.Get("Authorization"))
+	assert.Equal(t, "key", last.Header.Get("X-API-Key"))
+	assert.Equal(t, "app", last.URL.Query().Get("app_id"))
+
+	// Providers which fail are skipped in favor of the next requirement.
+	client.SecurityProviders["bearerAuth"] = func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer expired")
+		return errors.New("token expired")
+	}
+	_, err = client.SearchPets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, last.Header.Get("Authorization"))
+	_, err = client.ListPets(ctx)
+	assert.EqualError(t, err, "token expired")
+
+	// Requirements which no providers satisfy.
+	client, err = NewClient(server.URL, WithSecurityProviders(map[string]RequestEditorFn{"apiKey": apiKey.Intercept}))
+	require.NoError(t, err)
+	_, err = client.RunAdminTask(ctx)
+	assert.EqualError(t, err, "no security providers for the security requirements of POST /admin")
+}
+
+func TestSignatureSecurityProvider(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyPEM, err := ecdsafile.StorePrivateKey(key)
+	require.NoError(t, err)
+	signer, err := securityprovider.NewSecurityProviderHTTPSignature("client-1", keyPEM, "X-Request-Id")
+	require.NoError(t, err)
+
+	verifier := &httpsig.Verifier{
+		Keys:            httpsig.StaticKeys(map[string]crypto.PublicKey{"client-1": &key.PublicKey}),
+		RequiredHeaders: []string{"X-Request-Id"},
+	}
+	var verifyErr error
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, verifyErr = verifier.Verify(r)
+		buf, _ := io.ReadAll(r.Body)
+		body = string(buf)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// The signature covers the changes of the request editors, both those of
+	// the client and those of the call, which are applied before signing.
+	client, err := NewClient(server.URL,
+		WithRequestSigners(map[string]RequestEditorFn{"signature": signer.Intercept}),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-Request-Id", "client")
+			return nil
+		}))
+	require.NoError(t, err)
+
+	name := "Fido"
+	_, err = client.PostSigned(context.Background(), PostSignedJSONRequestBody{Name: &name}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Request-Id", "call")
+		req.URL.RawQuery = "verbose=true"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NoError(t, verifyErr)
+	assert.JSONEq(t, `{"name":"Fido"}`, body)
+}
@@ -1,6 +1,7 @@
 // Package components provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:304b65c8b113783dd6a74fa4b173d4a70bee96710ecac331738eeb8fa6fd5d70
 package components
 
 import (
@@ -18,6 +19,25 @@ const (
 	Enum1Two   Enum1 = "Two"
 )
 
+// AllEnum1Values returns all the values of Enum1.
+func AllEnum1Values() []Enum1 {
+	return []Enum1{
+		Enum1One,
+		Enum1Two,
+		Enum1Three,
+	}
+}
+
+// Valid returns whether the value of the Enum1 is one of the values of its enum.
+func (e Enum1) Valid() bool {
+	switch e {
+	case Enum1One, Enum1Two, Enum1Three:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum2.
 const (
 	Enum2Four  Enum2 = "Four"
@@ -25,6 +45,25 @@ const (
 	Enum2Two   Enum2 = "Two"
 )
 
+// AllEnum2Values returns all the values of Enum2.
+func AllEnum2Values() []Enum2 {
+	return []Enum2{
+		Enum2Two,
+		Enum2Three,
+		Enum2Four,
+	}
+}
+
+// Valid returns whether the value of the Enum2 is one of the values of its enum.
+func (e Enum2) Valid() bool {
+	switch e {
+	case Enum2Two, Enum2Three, Enum2Four:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum3.
 const (
 	Enum3Bar      Enum3 = "Bar"
@@ -32,6 +71,25 @@ const (
 	Enum3Foo      Enum3 = "Foo"
 )
 
+// AllEnum3Values returns all the values of Enum3.
+func AllEnum3Values() []Enum3 {
+	return []Enum3{
+		Enum3Enum1One,
+		Enum3Foo,
+		Enum3Bar,
+	}
+}
+
+// Valid returns whether the value of the Enum3 is one of the values of its enum.
+func (e Enum3) Valid() bool {
+	switch e {
+	case Enum3Enum1One, Enum3Foo, Enum3Bar:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum4.
 const (
 	Cat   Enum4 = "Cat"
@@ -39,6 +97,25 @@ const (
 	Mouse Enum4 = "Mouse"
 )
 
+// AllEnum4Values returns all the values of Enum4.
+func AllEnum4Values() []Enum4 {
+	return []Enum4{
+		Cat,
+		Dog,
+		Mouse,
+	}
+}
+
+// Valid returns whether the value of the Enum4 is one of the values of its enum.
+func (e Enum4) Valid() bool {
+	switch e {
+	case Cat, Dog, Mouse:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum5.
 const (
 	Enum5N5 Enum5 = 5
@@ -46,6 +123,25 @@ const (
 	Enum5N7 Enum5 = 7
 )
 
+// AllEnum5Values returns all the values of Enum5.
+func AllEnum5Values() []Enum5 {
+	return []Enum5{
+		Enum5N5,
+		Enum5N6,
+		Enum5N7,
+	}
+}
+
+// Valid returns whether the value of the Enum5 is one of the values of its enum.
+func (e Enum5) Valid() bool {
+	switch e {
+	case Enum5N5, Enum5N6, Enum5N7:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumUnion.
 const (
 	EnumUnionFour  EnumUnion = "Four"
@@ -54,6 +150,26 @@ const (
 	EnumUnionTwo   EnumUnion = "Two"
 )
 
+// AllEnumUnionValues returns all the values of EnumUnion.
+func AllEnumUnionValues() []EnumUnion {
+	return []EnumUnion{
+		EnumUnionFour,
+		EnumUnionOne,
+		EnumUnionThree,
+		EnumUnionTwo,
+	}
+}
+
+// Valid returns whether the value of the EnumUnion is one of the values of its enum.
+func (e EnumUnion) Valid() bool {
+	switch e {
+	case EnumUnionFour, EnumUnionOne, EnumUnionThree, EnumUnionTwo:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumUnion2.
 const (
 	EnumUnion2One   EnumUnion2 = "One"
@@ -62,6 +178,26 @@ const (
 	EnumUnion2Two   EnumUnion2 = "Two"
 )
 
+// AllEnumUnion2Values returns all the values of EnumUnion2.
+func AllEnumUnion2Values() []EnumUnion2 {
+	return []EnumUnion2{
+		EnumUnion2One,
+		EnumUnion2Seven,
+		EnumUnion2Three,
+		EnumUnion2Two,
+	}
+}
+
+// Valid returns whether the value of the EnumUnion2 is one of the values of its enum.
+func (e EnumUnion2) Valid() bool {
+	switch e {
+	case EnumUnion2One, EnumUnion2Seven, EnumUnion2Three, EnumUnion2Two:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FunnyValues.
 const (
 	FunnyValuesAnd      FunnyValues = "&"
@@ -71,6 +207,27 @@ const (
 	FunnyValuesPercent  FunnyValues = "%"
 )
 
+// AllFunnyValuesValues returns all the values of FunnyValues.
+func AllFunnyValuesValues() []FunnyValues {
+	return []FunnyValues{
+		FunnyValuesAsterisk,
+		FunnyValuesN5,
+		FunnyValuesAnd,
+		FunnyValuesPercent,
+		FunnyValuesEmpty,
+	}
+}
+
+// Valid returns whether the value of the FunnyValues is one of the values of its enum.
+func (e FunnyValues) Valid() bool {
+	switch e {
+	case FunnyValuesAsterisk, FunnyValuesN5, FunnyValuesAnd, FunnyValuesPercent, FunnyValuesEmpty:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam1.
 const (
 	EnumParam1Both EnumParam1 = "both"
@@ -78,6 +235,25 @@ const (
 	EnumParam1On   EnumParam1 = "on"
 )
 
+// AllEnumParam1Values returns all the values of EnumParam1.
+func AllEnumParam1Values() []EnumParam1 {
+	return []EnumParam1{
+		EnumParam1On,
+		EnumParam1Off,
+		EnumParam1Both,
+	}
+}
+
+// Valid returns whether the value of the EnumParam1 is one of the values of its enum.
+func (e EnumParam1) Valid() bool {
+	switch e {
+	case EnumParam1On, EnumParam1Off, EnumParam1Both:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam2.
 const (
 	EnumParam2Both EnumParam2 = "both"
@@ -85,6 +261,25 @@ const (
 	EnumParam2On   EnumParam2 = "on"
 )
 
+// AllEnumParam2Values returns all the values of EnumParam2.
+func AllEnumParam2Values() []EnumParam2 {
+	return []EnumParam2{
+		EnumParam2On,
+		EnumParam2Off,
+		EnumParam2Both,
+	}
+}
+
+// Valid returns whether the value of the EnumParam2 is one of the values of its enum.
+func (e EnumParam2) Valid() bool {
+	switch e {
+	case EnumParam2On, EnumParam2Off, EnumParam2Both:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam3.
 const (
 	Alice EnumParam3 = "alice"
@@ -92,6 +287,25 @@ const (
 	Eve   EnumParam3 = "eve"
 )
 
+// AllEnumParam3Values returns all the values of EnumParam3.
+func AllEnumParam3Values() []EnumParam3 {
+	return []EnumParam3{
+		Alice,
+		Eve,
+		Bob,
+	}
+}
+
+// Valid returns whether the value of the EnumParam3 is one of the values of its enum.
+func (e EnumParam3) Valid() bool {
+	switch e {
+	case Alice, Eve, Bob:
+		return true
+	default:
+		return false
+	}
+}
+
 // AdditionalPropertiesObject1 Has additional properties of type int
 type AdditionalPropertiesObject1 struct {
 	Id                   int            `json:"id"`
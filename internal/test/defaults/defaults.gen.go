@@ -0,0 +1,403 @@
+// Package defaults provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:4dbad65b97a0298e5bd25bb3a87036e6c7b1415cd5596db52bbd89bddf35fc1d
+package defaults
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/go-chi/chi/v5"
+)
+
+// Defines values for Kind.
+const (
+	Cat Kind = "cat"
+	Dog Kind = "dog"
+)
+
+// AllKindValues returns all the values of Kind.
+func AllKindValues() []Kind {
+	return []Kind{
+		Cat,
+		Dog,
+	}
+}
+
+// Valid returns whether the value of the Kind is one of the values of its enum.
+func (e Kind) Valid() bool {
+	switch e {
+	case Cat, Dog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kind defines model for Kind.
+type Kind string
+
+// Owner defines model for Owner.
+type Owner struct {
+	Country *string `json:"country,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Adopted *time.Time          `json:"adopted,omitempty"`
+	Age     *int                `json:"age,omitempty"`
+	Born    *openapi_types.Date `json:"born,omitempty"`
+	Collar  *struct {
+		Color *string `json:"color,omitempty"`
+	} `json:"collar,omitempty"`
+	Kind       *Kind             `json:"kind,omitempty"`
+	Name       string            `json:"name"`
+	Nickname   *string           `json:"nickname"`
+	Owner      *Owner            `json:"owner,omitempty"`
+	Toys       *Toys             `json:"toys,omitempty"`
+	Vaccinated *bool             `json:"vaccinated,omitempty"`
+	Vets       *map[string]Owner `json:"vets,omitempty"`
+	Weight     *float32          `json:"weight,omitempty"`
+}
+
+// Toy defines model for Toy.
+type Toy struct {
+	Country *string `json:"country,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Squeaky *bool   `json:"squeaky,omitempty"`
+}
+
+// Toys defines model for Toys.
+type Toys = []Toy
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Limit          *int32    `form:"limit,omitempty" json:"limit,omitempty"`
+	Kind           *Kind     `form:"kind,omitempty" json:"kind,omitempty"`
+	Tags           *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+	XRequestSource *string   `json:"X-Request-Source,omitempty"`
+	Session        *string   `form:"session,omitempty" json:"session,omitempty"`
+}
+
+// ApplyDefaults sets the absent properties of the Owner to the default values of their schemas.
+func (t *Owner) ApplyDefaults() {
+	if t.Country == nil {
+		var defaultValue string = "SE"
+		t.Country = &defaultValue
+	}
+}
+
+// ApplyDefaults sets the absent properties of the Pet to the default values of their schemas.
+func (t *Pet) ApplyDefaults() {
+	if t.Adopted == nil {
+		var defaultValue time.Time = time.Date(2021, 3, 4, 4, 6, 7, 0, time.UTC)
+		t.Adopted = &defaultValue
+	}
+	if t.Age == nil {
+		var defaultValue int = 1
+		t.Age = &defaultValue
+	}
+	if t.Born == nil {
+		var defaultValue openapi_types.Date = openapi_types.Date{Time: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)}
+		t.Born = &defaultValue
+	}
+	if t.Collar != nil {
+		if t.Collar.Color == nil {
+			var defaultValue string = "red"
+			t.Collar.Color = &defaultValue
+		}
+	}
+	if t.Kind == nil {
+		var defaultValue Kind = "cat"
+		t.Kind = &defaultValue
+	}
+	if t.Owner != nil {
+		t.Owner.ApplyDefaults()
+	}
+	if t.Toys != nil {
+		for k1 := range *t.Toys {
+			(*t.Toys)[k1].ApplyDefaults()
+		}
+	}
+	if t.Vaccinated == nil {
+		var defaultValue bool = true
+		t.Vaccinated = &defaultValue
+	}
+	if t.Vets != nil {
+		for k1, v1 := range *t.Vets {
+			v1.ApplyDefaults()
+			(*t.Vets)[k1] = v1
+		}
+	}
+	if t.Weight == nil {
+		var defaultValue float32 = 4.5
+		t.Weight = &defaultValue
+	}
+}
+
+// ApplyDefaults sets the absent properties of the Toy to the default values of their schemas.
+func (t *Toy) ApplyDefaults() {
+	if t.Country == nil {
+		var defaultValue string = "SE"
+		t.Country = &defaultValue
+	}
+	if t.Squeaky == nil {
+		var defaultValue bool = false
+		t.Squeaky = &defaultValue
+	}
+}
+
+// ApplyDefaults sets the absent properties of the FindPetsParams to the default values of their schemas.
+func (t *FindPetsParams) ApplyDefaults() {
+	if t.Limit == nil {
+		var defaultValue int32 = 20
+		t.Limit = &defaultValue
+	}
+	if t.Kind == nil {
+		var defaultValue Kind = "cat"
+		t.Kind = &defaultValue
+	}
+	if t.Tags == nil {
+		var defaultValue []string = []string{"cute", "fluffy"}
+		t.Tags = &defaultValue
+	}
+	if t.XRequestSource == nil {
+		var defaultValue string = "unknown"
+		t.XRequestSource = &defaultValue
+	}
+	if t.Session == nil {
+		var defaultValue string = "anonymous"
+		t.Session = &defaultValue
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /pets)
+func (_ Unimplemented) FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// FindPets operation middleware
+func (siw *ServerInterfaceWrapper) FindPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPetsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Request-Source" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-Source")]; found {
+		var XRequestSource string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-Source", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-Source", runtime.ParamLocationHeader, valueList[0], &XRequestSource)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-Source", Err: err})
+			return
+		}
+
+		params.XRequestSource = &XRequestSource
+
+	}
+
+	var cookie *http.Cookie
+
+	if cookie, err = r.Cookie("session"); err == nil {
+		var value string
+		err = runtime.BindStyledParameter("simple", true, "session", cookie.Value, &value)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+			return
+		}
+		params.Session = &value
+
+	}
+
+	// Fill in the default values of the parameters which weren't provided
+	params.ApplyDefaults()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindPets(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", wrapper.FindPets)
+	})
+
+	return r
+}
@@ -0,0 +1,84 @@
+package defaults
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	const buf = `{
+		"name": "Tom",
+		"age": 3,
+		"nickname": null,
+		"owner": {"name": "Jon"},
+		"toys": [{"name": "ball", "squeaky": true}, {"country": "NO"}],
+		"collar": {},
+		"vets": {"north": {"name": "Bob"}}
+	}`
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(buf), &pet))
+	pet.ApplyDefaults()
+
+	// Provided values are kept:
+	assert.Equal(t, "Tom", pet.Name)
+	assert.Equal(t, 3, *pet.Age)
+	assert.Nil(t, pet.Nickname)
+	assert.Equal(t, "Jon", *pet.Owner.Name)
+	assert.True(t, *(*pet.Toys)[0].Squeaky)
+	assert.Equal(t, "NO", *(*pet.Toys)[1].Country)
+
+	// Absent values are defaulted, including in nested objects:
+	assert.Equal(t, Cat, *pet.Kind)
+	assert.Equal(t, float32(4.5), *pet.Weight)
+	assert.True(t, *pet.Vaccinated)
+	assert.Equal(t, "2020-02-29", pet.Born.String())
+	assert.Equal(t, time.Date(2021, 3, 4, 5, 6, 7, 0, time.FixedZone("", 3600)).Unix(), pet.Adopted.Unix())
+	assert.Equal(t, "SE", *pet.Owner.Country)
+	assert.Equal(t, "SE", *(*pet.Toys)[0].Country)
+	assert.False(t, *(*pet.Toys)[1].Squeaky)
+	assert.Equal(t, "red", *pet.Collar.Color)
+	assert.Equal(t, "SE", *(*pet.Vets)["north"].Country)
+
+	// Absent objects aren't created:
+	pet = Pet{}
+	pet.ApplyDefaults()
+	assert.Nil(t, pet.Owner)
+	assert.Nil(t, pet.Collar)
+}
+
+type server struct {
+	params FindPetsParams
+}
+
+func (s *server) FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams) {
+	s.params = params
+}
+
+func TestParameterDefaults(t *testing.T) {
+	var s server
+	handler := Handler(&s)
+
+	req := httptest.NewRequest(http.MethodGet, "/pets", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, int32(20), *s.params.Limit)
+	assert.Equal(t, Cat, *s.params.Kind)
+	assert.Equal(t, []string{"cute", "fluffy"}, *s.params.Tags)
+	assert.Equal(t, "unknown", *s.params.XRequestSource)
+	assert.Equal(t, "anonymous", *s.params.Session)
+
+	req = httptest.NewRequest(http.MethodGet, "/pets?limit=5&kind=dog&tags=old", nil)
+	req.Header.Set("X-Request-Source", "test")
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, int32(5), *s.params.Limit)
+	assert.Equal(t, Dog, *s.params.Kind)
+	assert.Equal(t, []string{"old"}, *s.params.Tags)
+	assert.Equal(t, "test", *s.params.XRequestSource)
+	assert.Equal(t, "abc", *s.params.Session)
+}
@@ -0,0 +1,206 @@
+// Package enums provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:6344019193af42789354aa6b951c3271f54aa84c640de86158ef61220c0e31fc
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Defines values for Color.
+const (
+	Blue  Color = "blue"
+	Green Color = "green"
+	Red   Color = "red"
+)
+
+// AllColorValues returns all the values of Color.
+func AllColorValues() []Color {
+	return []Color{
+		Red,
+		Green,
+		Blue,
+	}
+}
+
+// Valid returns whether the value of the Color is one of the values of its enum.
+func (e Color) Valid() bool {
+	switch e {
+	case Red, Green, Blue:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values which
+// aren't values of the enum.
+func (e Color) MarshalText() ([]byte, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("invalid value for Color: %q", string(e))
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails for values which
+// aren't values of the enum.
+func (e *Color) UnmarshalText(text []byte) error {
+	if value := Color(text); value.Valid() {
+		*e = value
+		return nil
+	}
+	return fmt.Errorf("invalid value for Color: %q", string(text))
+}
+
+// Defines values for Priority.
+const (
+	High   Priority = 3
+	Low    Priority = 1
+	Medium Priority = 2
+)
+
+// AllPriorityValues returns all the values of Priority.
+func AllPriorityValues() []Priority {
+	return []Priority{
+		High,
+		Low,
+		Medium,
+	}
+}
+
+// Valid returns whether the value of the Priority is one of the values of its enum.
+func (e Priority) Valid() bool {
+	switch e {
+	case High, Low, Medium:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the name of the value of the Priority.
+func (e Priority) String() string {
+	switch e {
+	case High:
+		return "High"
+	case Low:
+		return "Low"
+	case Medium:
+		return "Medium"
+	default:
+		return strconv.FormatInt(int64(e), 10)
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values which
+// aren't values of the enum.
+func (e Priority) MarshalText() ([]byte, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("invalid value for Priority: %v", int(e))
+	}
+	return json.Marshal(int(e))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails for values which
+// aren't values of the enum.
+func (e *Priority) UnmarshalText(text []byte) error {
+	var value int
+	if err := json.Unmarshal(text, &value); err != nil {
+		return fmt.Errorf("invalid value for Priority: %w", err)
+	}
+	if !Priority(value).Valid() {
+		return fmt.Errorf("invalid value for Priority: %v", value)
+	}
+	*e = Priority(value)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, so that the Priority keeps the
+// JSON representation of int, rather than the string its MarshalText
+// method would make it.
+func (e Priority) MarshalJSON() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Priority) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
+// Defines values for Size.
+const (
+	N1 Size = 1
+	N2 Size = 2
+)
+
+// AllSizeValues returns all the values of Size.
+func AllSizeValues() []Size {
+	return []Size{
+		N1,
+		N2,
+	}
+}
+
+// Valid returns whether the value of the Size is one of the values of its enum.
+func (e Size) Valid() bool {
+	switch e {
+	case N1, N2:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails for values which
+// aren't values of the enum.
+func (e Size) MarshalText() ([]byte, error) {
+	if !e.Valid() {
+		return nil, fmt.Errorf("invalid value for Size: %v", int(e))
+	}
+	return json.Marshal(int(e))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails for values which
+// aren't values of the enum.
+func (e *Size) UnmarshalText(text []byte) error {
+	var value int
+	if err := json.Unmarshal(text, &value); err != nil {
+		return fmt.Errorf("invalid value for Size: %w", err)
+	}
+	if !Size(value).Valid() {
+		return fmt.Errorf("invalid value for Size: %v", value)
+	}
+	*e = Size(value)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, so that the Size keeps the
+// JSON representation of int, rather than the string its MarshalText
+// method would make it.
+func (e Size) MarshalJSON() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Size) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
+// Color defines model for Color.
+type Color string
+
+// Priority defines model for Priority.
+type Priority int
+
+// Shirt defines model for Shirt.
+type Shirt struct {
+	Color    *Color    `json:"color,omitempty"`
+	Priority *Priority `json:"priority,omitempty"`
+	Size     *Size     `json:"size,omitempty"`
+}
+
+// Size defines model for Size.
+type Size int
@@ -0,0 +1,57 @@
+package enums
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+func TestEnumValues(t *testing.T) {
+	// Values are listed in the order of the schema.
+	assert.Equal(t, []Color{Red, Green, Blue}, AllColorValues())
+	assert.Equal(t, []Priority{High, Low, Medium}, AllPriorityValues())
+
+	assert.True(t, Green.Valid())
+	assert.False(t, Color("purple").Valid())
+	assert.True(t, Size(2).Valid())
+	assert.False(t, Size(3).Valid())
+}
+
+func TestEnumString(t *testing.T) {
+	assert.Equal(t, "Medium", Medium.String())
+	assert.Equal(t, "7", Priority(7).String())
+}
+
+func TestEnumMarshaling(t *testing.T) {
+	var shirt Shirt
+	require.NoError(t, json.Unmarshal([]byte(`{"color": "red", "priority": 1, "size": 2}`), &shirt))
+	assert.Equal(t, Red, *shirt.Color)
+	assert.Equal(t, Low, *shirt.Priority)
+	assert.Equal(t, Size(2), *shirt.Size)
+
+	buf, err := json.Marshal(shirt)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"color": "red", "priority": 1, "size": 2}`, string(buf))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"color": "purple"}`), &shirt))
+	assert.Error(t, json.Unmarshal([]byte(`{"priority": 4}`), &shirt))
+	assert.Error(t, json.Unmarshal([]byte(`{"size": "1"}`), &shirt))
+
+	invalid := Color("purple")
+	_, err = json.Marshal(Shirt{Color: &invalid})
+	assert.Error(t, err)
+}
+
+func TestEnumParameterBinding(t *testing.T) {
+	var priority Priority
+	require.NoError(t, runtime.BindStyledParameterWithLocation("simple", false, "priority", runtime.ParamLocationQuery, "3", &priority))
+	assert.Equal(t, High, priority)
+	assert.Error(t, runtime.BindStyledParameterWithLocation("simple", false, "priority", runtime.ParamLocationQuery, "4", &priority))
+
+	var color Color
+	assert.Error(t, runtime.BindStyledParameterWithLocation("simple", false, "color", runtime.ParamLocationQuery, "purple", &color))
+}
@@ -1,6 +1,7 @@
 // Package externalref provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:2b7a62e57b59ac849f8ea4dfe1978df2dc3284cd3687485a5c6a7e5f69d06ad3
 package externalref
 
 import (
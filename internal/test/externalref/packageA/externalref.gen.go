@@ -1,6 +1,7 @@
 // Package packagea provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:44903c17df5340cdeb3ef59c89457a69fc2ca9d3221bb2f1cd0fdad5d0aed9f7
 package packagea
 
 import (
@@ -1,6 +1,7 @@
 // Package packageb provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:8762d4a36d8a99288535571a1a75634822fb871e8a74958d626e51ad7eeecbb6
 package packageb
 
 import (
@@ -1,6 +1,7 @@
 // Package deps provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:73a8a90e965e1d98bf7bb5326384cd9d85a188d674e19529edd201b63ff65439
 package deps
 
 import (
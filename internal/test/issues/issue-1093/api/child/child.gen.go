@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:33a826500c1c467469739a59673c99c77d96ca74525ff4cb384afdf0437356e2
 package api
 
 import (
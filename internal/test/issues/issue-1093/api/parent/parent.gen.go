@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:482c7c82df9b8fbf0e20a71e8d9fd8e937aaa80f71239d55bb7f4c5f4b8f49d8
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package issue1127 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:d9cafde7d8ecc0022a557efc37c0f1efa0e1d19741457961d29103f20de23e2d
 package issue1127
 
 // Whatever defines model for Whatever.
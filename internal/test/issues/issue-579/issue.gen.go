@@ -1,6 +1,7 @@
 // Package issue579 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:de317fa86fc7a9cd25db55dc35501ecb2d9b2b3c11f8e86d3d10019bbf797b5a
 package issue579
 
 import (
@@ -1,6 +1,7 @@
 // Package issue_832 provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:192cfef0ac0b0228db6127e3d5f6220df23acbbed1ab33d0c511a82c1e3ff897
 package issue_832
 
 import (
@@ -23,6 +24,26 @@ const (
 	Two   Document_Status = "two"
 )
 
+// AllDocument_StatusValues returns all the values of Document_Status.
+func AllDocument_StatusValues() []Document_Status {
+	return []Document_Status{
+		One,
+		Two,
+		Three,
+		Four,
+	}
+}
+
+// Valid returns whether the value of the Document_Status is one of the values of its enum.
+func (e Document_Status) Valid() bool {
+	switch e {
+	case One, Two, Three, Four:
+		return true
+	default:
+		return false
+	}
+}
+
 // Document defines model for Document.
 type Document struct {
 	Name   *string          `json:"name,omitempty"`
@@ -40,12 +61,12 @@ type DocumentStatus struct {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7ySz0/rMAzH/5XK7x27tq/vljMIIYQ47AgIhdRbM9o4Styxaer/jpxuReOHxIlL4zq2",
-	"vx/HPoCh3pNDxxHUAaJpsdfJvCAz9OhYbB/IY2CL6cbpHuXkvUdQEDlYt4Yxh8iahxSCbuhB3QM5hBz4",
-	"leTbBpS/FQ0BHvMP6TnsFmtaiHMxCcwET8up7jjOSfS8QcOieQpaztrnsFvdDV/Rfq4lLutWJMENRhOs",
-	"Z0sOFNzqF8ziEDDjVnMW0Awh2i1mUiFmOmDWatd02GSTeLd/cNKx5U4UcKd730nvWwxxqlkVVfFPGiCP",
-	"TnsLCv4XVVFDDl5zm9jLU6I6wBrTJKS6FqzrBhRcTvdXyJBDwOjJxantuqrkMOT4OEPtfWdNyi03URhO",
-	"4xbrb8AVKPhTvu9DeVyGct6E9ETnT3N3I94xn1nrH8DWv0E7L813zOP4FgAA//8tucJ2/gIAAA==",
+	"H4sIAAAAAAAC/7xSS2+bQBD+K2jaIwZKb3tuVVVV1YOPTRRtlrFZB2ZWu4Njy+K/RwMxlvOQcsqFHebx",
+	"fd88TuC4D0xIksCcILkWezuZP9gNPZKoHSIHjOJxipDtUV85BgQDSaKnLYw5JLEyTClIQw/mPzAh5CCP",
+	"rN82ov5teIhwm78oz+Gw2vJKnauZYFFwt55xx3Ep4vsdOlHOc9J64b4Wu7fd8Jba11jq8rRhTW4wueiD",
+	"eCYw8Nc+YJaGiJm0VrKIbojJ7zFThJTZiFlrqemwyWby7nhD2rGXThnwYPvQae97jGnGrIqq+KYNcECy",
+	"wYOB70VV1JBDsNJO2stzoTnBFqdNKLpVWb8bMPBzjv9CgRwipsCU5rbrqtLHMcnzDm0InXdTbblLTJd1",
+	"q/U14gYMfCkv91DO0VQulzCN6Ho0//6od8wXrfUHxNafoXY5mvc0j+PTAC25wnb+AgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,7 @@
 // Package headdigitofhttpheader provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:766f189b360fc47a97ebb653383c6b51df8b0ae760c0aaac5119f8e6f5812666
 package headdigitofhttpheader
 
 type N200ResponseHeaders struct {
@@ -1,4 +1,5 @@
 // Package head_digit_of_operation_id provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:11b3a0f0752c2540d823a1a0451ae06eeb9b2ddf48a35aa76b12a059a5ba266c
 package head_digit_of_operation_id
@@ -1,6 +1,7 @@
 // Package spec_base provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:ee840f88eb4bc7188f45b8acbc8bf4945c16829835b9d7f25d6fe525cbac0b27
 package spec_base
 
 import (
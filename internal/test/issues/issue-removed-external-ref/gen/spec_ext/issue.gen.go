@@ -1,6 +1,7 @@
 // Package spec_ext provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:f8ae4f7681ee9256e67a94692c825671bb594bfb8a76c5961c707cd0b2c371b6
 package spec_ext
 
 import (
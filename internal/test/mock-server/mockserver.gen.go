@@ -0,0 +1,605 @@
+// Package mockserver provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:b04e502e7c2db451f707e527575f9627c25655ef617c7a317b6410b6be25622e
+package mockserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// Defines values for PetKind.
+const (
+	Cat PetKind = "cat"
+	Dog PetKind = "dog"
+)
+
+// AllPetKindValues returns all the values of PetKind.
+func AllPetKindValues() []PetKind {
+	return []PetKind{
+		Cat,
+		Dog,
+	}
+}
+
+// Valid returns whether the value of the PetKind is one of the values of its enum.
+func (e PetKind) Valid() bool {
+	switch e {
+	case Cat, Dog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Born   *openapi_types.Date `json:"born,omitempty"`
+	Id     int64               `json:"id"`
+	Kind   *PetKind            `json:"kind,omitempty"`
+	Name   string              `json:"name"`
+	Secret *string             `json:"secret,omitempty"`
+}
+
+// PetKind defines model for Pet.Kind.
+type PetKind string
+
+// CreatePetJSONRequestBody defines body for CreatePet for application/json ContentType.
+type CreatePetJSONRequestBody = Pet
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request)
+
+	// (POST /pets)
+	CreatePet(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /pets/{id})
+	DeletePet(w http.ResponseWriter, r *http.Request, id int)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /pets)
+func (_ Unimplemented) ListPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /pets)
+func (_ Unimplemented) CreatePet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /pets/{id})
+func (_ Unimplemented) DeletePet(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListPets operation middleware
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePet operation middleware
+func (siw *ServerInterfaceWrapper) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeletePet operation middleware
+func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, r.PathValue("id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePet(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// ServeMux is an abstraction of http.ServeMux, on which the handlers are
+// registered with the method and wildcard patterns of Go 1.22.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/pets", wrapper.ListPets)
+
+	m.HandleFunc("POST "+options.BaseURL+"/pets", wrapper.CreatePet)
+
+	m.HandleFunc("DELETE "+options.BaseURL+"/pets/{id}", wrapper.DeletePet)
+
+	return m
+}
+
+type ErrorJSONResponse Error
+
+type ListPetsRequestObject struct {
+}
+
+type ListPetsResponseObject interface {
+	VisitListPetsResponse(w http.ResponseWriter) error
+}
+
+type ListPets200JSONResponse []Pet
+
+func (response ListPets200JSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPets200TextResponse string
+
+func (response ListPets200TextResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type ListPetsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListPetsdefaultJSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePetRequestObject struct {
+	Body *CreatePetJSONRequestBody
+}
+
+type CreatePetResponseObject interface {
+	VisitCreatePetResponse(w http.ResponseWriter) error
+}
+
+type CreatePet201JSONResponse Pet
+
+func (response CreatePet201JSONResponse) VisitCreatePetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePet4XXJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreatePet4XXJSONResponse) VisitCreatePetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeletePetRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeletePetResponseObject interface {
+	VisitDeletePetResponse(w http.ResponseWriter) error
+}
+
+type DeletePet204Response struct {
+}
+
+func (response DeletePet204Response) VisitDeletePetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePet404JSONResponse struct{ ErrorJSONResponse }
+
+func (response DeletePet404JSONResponse) VisitDeletePetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /pets)
+	ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error)
+
+	// (POST /pets)
+	CreatePet(ctx context.Context, request CreatePetRequestObject) (CreatePetResponseObject, error)
+
+	// (DELETE /pets/{id})
+	DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error)
+}
+
+type StrictHandlerFunc = runtime.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = runtime.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListPets operation middleware
+func (sh *strictHandler) ListPets(w http.ResponseWriter, r *http.Request) {
+	var request ListPetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPets(ctx, request.(ListPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPetsResponseObject); ok {
+		if err := validResponse.VisitListPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreatePet operation middleware
+func (sh *strictHandler) CreatePet(w http.ResponseWriter, r *http.Request) {
+	var request CreatePetRequestObject
+
+	var body CreatePetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePet(ctx, request.(CreatePetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePetResponseObject); ok {
+		if err := validResponse.VisitCreatePetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeletePet operation middleware
+func (sh *strictHandler) DeletePet(w http.ResponseWriter, r *http.Request, id int) {
+	var request DeletePetRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePet(ctx, request.(DeletePetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePetResponseObject); ok {
+		if err := validResponse.VisitDeletePetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// MockServer implements StrictServerInterface by responding with the examples
+// of the spec, or with samples derived from the schemas of the responses. The
+// response is selected from the Prefer and Accept headers of the request, when
+// the handler is wrapped with runtime.MockPreferencesMiddleware.
+type MockServer struct{}
+
+var _ StrictServerInterface = MockServer{}
+
+func (MockServer) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "200", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Name: "cats", Value: "[{\"id\":1,\"kind\":\"cat\",\"name\":\"Tom\"}]"},
+			{Name: "dogs", Value: "[{\"id\":2,\"kind\":\"dog\",\"name\":\"Rex\"}]"},
+		}},
+		{StatusCode: "200", ContentType: "text/plain", Examples: []runtime.MockExample{
+			{Value: "Tom, Rex"},
+		}},
+		{StatusCode: "default", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Value: "{\"message\":\"unexpected error\"}"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response ListPets200JSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of ListPets: %w", err)
+		}
+		return response, nil
+	case 1:
+		var response ListPets200TextResponse
+		response = ListPets200TextResponse(selection.Example)
+		return response, nil
+	case 2:
+		var response ListPetsdefaultJSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response.Body); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of ListPets: %w", err)
+		}
+		response.StatusCode = selection.StatusCode
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of ListPets at index %d", selection.Index)
+}
+
+func (MockServer) CreatePet(ctx context.Context, request CreatePetRequestObject) (CreatePetResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "201", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Value: "{\"born\":\"2006-01-02\",\"id\":1,\"kind\":\"cat\",\"name\":\"string\"}"},
+		}},
+		{StatusCode: "4XX", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Value: "{\"message\":\"invalid pet\"}"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response CreatePet201JSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of CreatePet: %w", err)
+		}
+		return response, nil
+	case 1:
+		var response CreatePet4XXJSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response.Body); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of CreatePet: %w", err)
+		}
+		response.StatusCode = selection.StatusCode
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of CreatePet at index %d", selection.Index)
+}
+
+func (MockServer) DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "204"},
+		{StatusCode: "404", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Value: "{\"message\":\"unexpected error\"}"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response DeletePet204Response
+		return response, nil
+	case 1:
+		var response DeletePet404JSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response.ErrorJSONResponse); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of DeletePet: %w", err)
+		}
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of DeletePet at index %d", selection.Index)
+}
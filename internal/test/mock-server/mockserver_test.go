@@ -0,0 +1,74 @@
+// The std-http server needs the ServeMux patterns of Go 1.22, which modules
+// with an older go directive, like this one, only get by asking for them.
+//go:debug httpmuxgo121=0
+
+package mockserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+func TestMockServer(t *testing.T) {
+	handler := runtime.MockPreferencesMiddleware(Handler(NewStrictHandler(MockServer{}, nil)))
+
+	do := func(method, path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(`{"id":1,"name":"Tom"}`))
+		req.Header.Set("Content-Type", "application/json")
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The first example of the first successful response.
+	rec := do(http.MethodGet, "/pets", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `[{"id":1,"name":"Tom","kind":"cat"}]`, rec.Body.String())
+
+	// A named example.
+	rec = do(http.MethodGet, "/pets", map[string]string{"Prefer": "example=dogs"})
+	assert.JSONEq(t, `[{"id":2,"name":"Rex","kind":"dog"}]`, rec.Body.String())
+
+	// The content type from the Accept header.
+	rec = do(http.MethodGet, "/pets", map[string]string{"Accept": "text/plain"})
+	assert.Equal(t, "text/plain", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "Tom, Rex", rec.Body.String())
+
+	// The status code from the Prefer header, matching the default response.
+	rec = do(http.MethodGet, "/pets", map[string]string{"Prefer": "code=503"})
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"message":"unexpected error"}`, rec.Body.String())
+
+	// A sample derived from the schema, without write-only properties.
+	rec = do(http.MethodPost, "/pets", nil)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"id":1,"name":"string","kind":"cat","born":"2006-01-02"}`, rec.Body.String())
+
+	// A range of status codes.
+	rec = do(http.MethodPost, "/pets", map[string]string{"Prefer": "code=422"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.JSONEq(t, `{"message":"invalid pet"}`, rec.Body.String())
+
+	// Responses without content, and referenced ones.
+	rec = do(http.MethodDelete, "/pets/1", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = do(http.MethodDelete, "/pets/1", map[string]string{"Prefer": "code=404"})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"message":"unexpected error"}`, rec.Body.String())
+
+	// Status codes which the operation doesn't declare.
+	rec = do(http.MethodDelete, "/pets/1", map[string]string{"Prefer": "code=500"})
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
@@ -0,0 +1,2083 @@
+// Package multipleservers provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:2e62119f89ed66981cef17943347f12f52b345f016ebf9778bf58b07bb4b99ad
+package multipleservers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/gin-gonic/gin"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+	"github.com/labstack/echo/v4"
+)
+
+// Defines values for GetWithContentTypeParamsContentType.
+const (
+	Json GetWithContentTypeParamsContentType = "json"
+	Text GetWithContentTypeParamsContentType = "text"
+)
+
+// AllGetWithContentTypeParamsContentTypeValues returns all the values of GetWithContentTypeParamsContentType.
+func AllGetWithContentTypeParamsContentTypeValues() []GetWithContentTypeParamsContentType {
+	return []GetWithContentTypeParamsContentType{
+		Text,
+		Json,
+	}
+}
+
+// Valid returns whether the value of the GetWithContentTypeParamsContentType is one of the values of its enum.
+func (e GetWithContentTypeParamsContentType) Valid() bool {
+	switch e {
+	case Text, Json:
+		return true
+	default:
+		return false
+	}
+}
+
+// EveryTypeOptional defines model for EveryTypeOptional.
+type EveryTypeOptional struct {
+	ArrayInlineField     *[]int              `json:"array_inline_field,omitempty"`
+	ArrayReferencedField *[]SomeObject       `json:"array_referenced_field,omitempty"`
+	BoolField            *bool               `json:"bool_field,omitempty"`
+	ByteField            *[]byte             `json:"byte_field,omitempty"`
+	DateField            *openapi_types.Date `json:"date_field,omitempty"`
+	DateTimeField        *time.Time          `json:"date_time_field,omitempty"`
+	DoubleField          *float64            `json:"double_field,omitempty"`
+	FloatField           *float32            `json:"float_field,omitempty"`
+	InlineObjectField    *struct {
+		Name   string `json:"name"`
+		Number int    `json:"number"`
+	} `json:"inline_object_field,omitempty"`
+	Int32Field      *int32      `json:"int32_field,omitempty"`
+	Int64Field      *int64      `json:"int64_field,omitempty"`
+	IntField        *int        `json:"int_field,omitempty"`
+	NumberField     *float32    `json:"number_field,omitempty"`
+	ReferencedField *SomeObject `json:"referenced_field,omitempty"`
+	StringField     *string     `json:"string_field,omitempty"`
+}
+
+// EveryTypeRequired defines model for EveryTypeRequired.
+type EveryTypeRequired struct {
+	ArrayInlineField     []int                `json:"array_inline_field"`
+	ArrayReferencedField []SomeObject         `json:"array_referenced_field"`
+	BoolField            bool                 `json:"bool_field"`
+	ByteField            []byte               `json:"byte_field"`
+	DateField            openapi_types.Date   `json:"date_field"`
+	DateTimeField        time.Time            `json:"date_time_field"`
+	DoubleField          float64              `json:"double_field"`
+	EmailField           *openapi_types.Email `json:"email_field,omitempty"`
+	FloatField           float32              `json:"float_field"`
+	InlineObjectField    struct {
+		Name   string `json:"name"`
+		Number int    `json:"number"`
+	} `json:"inline_object_field"`
+	Int32Field      int32      `json:"int32_field"`
+	Int64Field      int64      `json:"int64_field"`
+	IntField        int        `json:"int_field"`
+	NumberField     float32    `json:"number_field"`
+	ReferencedField SomeObject `json:"referenced_field"`
+	StringField     string     `json:"string_field"`
+}
+
+// ReservedKeyword defines model for ReservedKeyword.
+type ReservedKeyword struct {
+	Channel *string `json:"channel,omitempty"`
+}
+
+// Resource defines model for Resource.
+type Resource struct {
+	Name  string  `json:"name"`
+	Value float32 `json:"value"`
+}
+
+// SomeObject defines model for some_object.
+type SomeObject struct {
+	Name string `json:"name"`
+}
+
+// Argument defines model for argument.
+type Argument = string
+
+// ResponseWithReference defines model for ResponseWithReference.
+type ResponseWithReference = SomeObject
+
+// SimpleResponse defines model for SimpleResponse.
+type SimpleResponse struct {
+	Name string `json:"name"`
+}
+
+// GetWithArgsParams defines parameters for GetWithArgs.
+type GetWithArgsParams struct {
+	// OptionalArgument An optional query argument
+	OptionalArgument *int64 `form:"optional_argument,omitempty" json:"optional_argument,omitempty"`
+
+	// RequiredArgument An optional query argument
+	RequiredArgument int64 `form:"required_argument" json:"required_argument"`
+
+	// HeaderArgument An optional query argument
+	HeaderArgument *int32 `json:"header_argument,omitempty"`
+}
+
+// GetWithContentTypeParamsContentType defines parameters for GetWithContentType.
+type GetWithContentTypeParamsContentType string
+
+// CreateResource2Params defines parameters for CreateResource2.
+type CreateResource2Params struct {
+	// InlineQueryArgument Some query argument
+	InlineQueryArgument *int `form:"inline_query_argument,omitempty" json:"inline_query_argument,omitempty"`
+}
+
+// UpdateResource3JSONBody defines parameters for UpdateResource3.
+type UpdateResource3JSONBody struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
+type CreateResourceJSONRequestBody = EveryTypeRequired
+
+// CreateResource2JSONRequestBody defines body for CreateResource2 for application/json ContentType.
+type CreateResource2JSONRequestBody = Resource
+
+// UpdateResource3JSONRequestBody defines body for UpdateResource3 for application/json ContentType.
+type UpdateResource3JSONRequestBody UpdateResource3JSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// get every type optional
+	// (GET /every-type-optional)
+	GetEveryTypeOptional(w http.ResponseWriter, r *http.Request)
+	// Get resource via simple path
+	// (GET /get-simple)
+	GetSimple(w http.ResponseWriter, r *http.Request)
+	// Getter with referenced parameter and referenced response
+	// (GET /get-with-args)
+	GetWithArgs(w http.ResponseWriter, r *http.Request, params GetWithArgsParams)
+	// Getter with referenced parameter and referenced response
+	// (GET /get-with-references/{global_argument}/{argument})
+	GetWithReferences(w http.ResponseWriter, r *http.Request, globalArgument int64, argument Argument)
+	// Get an object by ID
+	// (GET /get-with-type/{content_type})
+	GetWithContentType(w http.ResponseWriter, r *http.Request, contentType GetWithContentTypeParamsContentType)
+	// get with reserved keyword
+	// (GET /reserved-keyword)
+	GetReservedKeyword(w http.ResponseWriter, r *http.Request)
+	// Create a resource
+	// (POST /resource/{argument})
+	CreateResource(w http.ResponseWriter, r *http.Request, argument Argument)
+	// Create a resource with inline parameter
+	// (POST /resource2/{inline_argument})
+	CreateResource2(w http.ResponseWriter, r *http.Request, inlineArgument int, params CreateResource2Params)
+	// Update a resource with inline body. The parameter name is a reserved
+	// keyword, so make sure that gets prefixed to avoid syntax errors
+	// (PUT /resource3/{fallthrough})
+	UpdateResource3(w http.ResponseWriter, r *http.Request, pFallthrough int)
+	// get response with reference
+	// (GET /response-with-reference)
+	GetResponseWithReference(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// get every type optional
+// (GET /every-type-optional)
+func (_ Unimplemented) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get resource via simple path
+// (GET /get-simple)
+func (_ Unimplemented) GetSimple(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Getter with referenced parameter and referenced response
+// (GET /get-with-args)
+func (_ Unimplemented) GetWithArgs(w http.ResponseWriter, r *http.Request, params GetWithArgsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Getter with referenced parameter and referenced response
+// (GET /get-with-references/{global_argument}/{argument})
+func (_ Unimplemented) GetWithReferences(w http.ResponseWriter, r *http.Request, globalArgument int64, argument Argument) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an object by ID
+// (GET /get-with-type/{content_type})
+func (_ Unimplemented) GetWithContentType(w http.ResponseWriter, r *http.Request, contentType GetWithContentTypeParamsContentType) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get with reserved keyword
+// (GET /reserved-keyword)
+func (_ Unimplemented) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a resource
+// (POST /resource/{argument})
+func (_ Unimplemented) CreateResource(w http.ResponseWriter, r *http.Request, argument Argument) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a resource with inline parameter
+// (POST /resource2/{inline_argument})
+func (_ Unimplemented) CreateResource2(w http.ResponseWriter, r *http.Request, inlineArgument int, params CreateResource2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a resource with inline body. The parameter name is a reserved
+// keyword, so make sure that gets prefixed to avoid syntax errors
+// (PUT /resource3/{fallthrough})
+func (_ Unimplemented) UpdateResource3(w http.ResponseWriter, r *http.Request, pFallthrough int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// get response with reference
+// (GET /response-with-reference)
+func (_ Unimplemented) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// EchoServerInterfaceWrapper converts echo contexts to parameters.
+type EchoServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetEveryTypeOptional converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetEveryTypeOptional(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetEveryTypeOptional(ctx.Response(), ctx.Request())
+	return err
+}
+
+// GetSimple converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetSimple(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetSimple(ctx.Response(), ctx.Request())
+	return err
+}
+
+// GetWithArgs converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetWithArgs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWithArgsParams
+	// ------------- Optional query parameter "optional_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "optional_argument", ctx.QueryParams(), &params.OptionalArgument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter optional_argument: %s", err))
+	}
+
+	// ------------- Required query parameter "required_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "required_argument", ctx.QueryParams(), &params.RequiredArgument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter required_argument: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "header_argument" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header_argument")]; found {
+		var HeaderArgument int32
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for header_argument, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0], &HeaderArgument)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter header_argument: %s", err))
+		}
+
+		params.HeaderArgument = &HeaderArgument
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetWithArgs(ctx.Response(), ctx.Request(), params)
+	return err
+}
+
+// GetWithReferences converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetWithReferences(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "global_argument" -------------
+	var globalArgument int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "global_argument", runtime.ParamLocationPath, ctx.Param("global_argument"), &globalArgument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter global_argument: %s", err))
+	}
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "argument", runtime.ParamLocationPath, ctx.Param("argument"), &argument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter argument: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetWithReferences(ctx.Response(), ctx.Request(), globalArgument, argument)
+	return err
+}
+
+// GetWithContentType converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetWithContentType(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "content_type" -------------
+	var contentType GetWithContentTypeParamsContentType
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "content_type", runtime.ParamLocationPath, ctx.Param("content_type"), &contentType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter content_type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetWithContentType(ctx.Response(), ctx.Request(), contentType)
+	return err
+}
+
+// GetReservedKeyword converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetReservedKeyword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetReservedKeyword(ctx.Response(), ctx.Request())
+	return err
+}
+
+// CreateResource converts echo context to params.
+func (w *EchoServerInterfaceWrapper) CreateResource(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "argument", runtime.ParamLocationPath, ctx.Param("argument"), &argument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter argument: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.CreateResource(ctx.Response(), ctx.Request(), argument)
+	return err
+}
+
+// CreateResource2 converts echo context to params.
+func (w *EchoServerInterfaceWrapper) CreateResource2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "inline_argument" -------------
+	var inlineArgument int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "inline_argument", runtime.ParamLocationPath, ctx.Param("inline_argument"), &inlineArgument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inline_argument: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResource2Params
+	// ------------- Optional query parameter "inline_query_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", ctx.QueryParams(), &params.InlineQueryArgument)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inline_query_argument: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.CreateResource2(ctx.Response(), ctx.Request(), inlineArgument, params)
+	return err
+}
+
+// UpdateResource3 converts echo context to params.
+func (w *EchoServerInterfaceWrapper) UpdateResource3(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "fallthrough" -------------
+	var pFallthrough int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fallthrough", runtime.ParamLocationPath, ctx.Param("fallthrough"), &pFallthrough)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fallthrough: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.UpdateResource3(ctx.Response(), ctx.Request(), pFallthrough)
+	return err
+}
+
+// GetResponseWithReference converts echo context to params.
+func (w *EchoServerInterfaceWrapper) GetResponseWithReference(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.GetResponseWithReference(ctx.Response(), ctx.Request())
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterEchoHandlers adds each server route to the EchoRouter.
+func RegisterEchoHandlers(router EchoRouter, si ServerInterface) {
+	RegisterEchoHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterEchoHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := EchoServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/every-type-optional", wrapper.GetEveryTypeOptional)
+	router.GET(baseURL+"/get-simple", wrapper.GetSimple)
+	router.GET(baseURL+"/get-with-args", wrapper.GetWithArgs)
+	router.GET(baseURL+"/get-with-references/:global_argument/:argument", wrapper.GetWithReferences)
+	router.GET(baseURL+"/get-with-type/:content_type", wrapper.GetWithContentType)
+	router.GET(baseURL+"/reserved-keyword", wrapper.GetReservedKeyword)
+	router.POST(baseURL+"/resource/:argument", wrapper.CreateResource)
+	router.POST(baseURL+"/resource2/:inline_argument", wrapper.CreateResource2)
+	router.PUT(baseURL+"/resource3/:fallthrough", wrapper.UpdateResource3)
+	router.GET(baseURL+"/response-with-reference", wrapper.GetResponseWithReference)
+
+}
+
+// ChiServerInterfaceWrapper converts contexts to parameters.
+type ChiServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []ChiMiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type ChiMiddlewareFunc func(http.Handler) http.Handler
+
+// GetEveryTypeOptional operation middleware
+func (siw *ChiServerInterfaceWrapper) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEveryTypeOptional(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSimple operation middleware
+func (siw *ChiServerInterfaceWrapper) GetSimple(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSimple(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWithArgs operation middleware
+func (siw *ChiServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWithArgsParams
+
+	// ------------- Optional query parameter "optional_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "optional_argument", r.URL.Query(), &params.OptionalArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "optional_argument", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "required_argument" -------------
+
+	if paramValue := r.URL.Query().Get("required_argument"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "required_argument"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "required_argument", r.URL.Query(), &params.RequiredArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "required_argument", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "header_argument" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header_argument")]; found {
+		var HeaderArgument int32
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "header_argument", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0], &HeaderArgument)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "header_argument", Err: err})
+			return
+		}
+
+		params.HeaderArgument = &HeaderArgument
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithArgs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWithReferences operation middleware
+func (siw *ChiServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "global_argument" -------------
+	var globalArgument int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "global_argument", runtime.ParamLocationPath, chi.URLParam(r, "global_argument"), &globalArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "global_argument", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "argument", runtime.ParamLocationPath, chi.URLParam(r, "argument"), &argument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "argument", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithReferences(w, r, globalArgument, argument)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWithContentType operation middleware
+func (siw *ChiServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "content_type" -------------
+	var contentType GetWithContentTypeParamsContentType
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "content_type", runtime.ParamLocationPath, chi.URLParam(r, "content_type"), &contentType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "content_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithContentType(w, r, contentType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetReservedKeyword operation middleware
+func (siw *ChiServerInterfaceWrapper) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReservedKeyword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateResource operation middleware
+func (siw *ChiServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "argument", runtime.ParamLocationPath, chi.URLParam(r, "argument"), &argument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "argument", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResource(w, r, argument)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateResource2 operation middleware
+func (siw *ChiServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "inline_argument" -------------
+	var inlineArgument int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "inline_argument", runtime.ParamLocationPath, chi.URLParam(r, "inline_argument"), &inlineArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inline_argument", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResource2Params
+
+	// ------------- Optional query parameter "inline_query_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", r.URL.Query(), &params.InlineQueryArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inline_query_argument", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResource2(w, r, inlineArgument, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateResource3 operation middleware
+func (siw *ChiServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "fallthrough" -------------
+	var pFallthrough int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "fallthrough", runtime.ParamLocationPath, chi.URLParam(r, "fallthrough"), &pFallthrough)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fallthrough", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateResource3(w, r, pFallthrough)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetResponseWithReference operation middleware
+func (siw *ChiServerInterfaceWrapper) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResponseWithReference(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func ChiHandler(si ServerInterface) http.Handler {
+	return ChiHandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []ChiMiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func ChiHandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return ChiHandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func ChiHandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return ChiHandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func ChiHandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ChiServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/every-type-optional", wrapper.GetEveryTypeOptional)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/get-simple", wrapper.GetSimple)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/get-with-args", wrapper.GetWithArgs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/get-with-type/{content_type}", wrapper.GetWithContentType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reserved-keyword", wrapper.GetReservedKeyword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resource/{argument}", wrapper.CreateResource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/resource2/{inline_argument}", wrapper.CreateResource2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/resource3/{fallthrough}", wrapper.UpdateResource3)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/response-with-reference", wrapper.GetResponseWithReference)
+	})
+
+	return r
+}
+
+// GinServerInterfaceWrapper converts contexts to parameters.
+type GinServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []GinMiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type GinMiddlewareFunc func(c *gin.Context)
+
+// GetEveryTypeOptional operation middleware
+func (siw *GinServerInterfaceWrapper) GetEveryTypeOptional(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEveryTypeOptional(c.Writer, c.Request)
+}
+
+// GetSimple operation middleware
+func (siw *GinServerInterfaceWrapper) GetSimple(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSimple(c.Writer, c.Request)
+}
+
+// GetWithArgs operation middleware
+func (siw *GinServerInterfaceWrapper) GetWithArgs(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWithArgsParams
+
+	// ------------- Optional query parameter "optional_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "optional_argument", c.Request.URL.Query(), &params.OptionalArgument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter optional_argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "required_argument" -------------
+
+	if paramValue := c.Query("required_argument"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument required_argument is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "required_argument", c.Request.URL.Query(), &params.RequiredArgument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter required_argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "header_argument" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header_argument")]; found {
+		var HeaderArgument int32
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for header_argument, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0], &HeaderArgument)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter header_argument: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.HeaderArgument = &HeaderArgument
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWithArgs(c.Writer, c.Request, params)
+}
+
+// GetWithReferences operation middleware
+func (siw *GinServerInterfaceWrapper) GetWithReferences(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "global_argument" -------------
+	var globalArgument int64
+
+	err = runtime.BindStyledParameter("simple", false, "global_argument", c.Param("global_argument"), &globalArgument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter global_argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameter("simple", false, "argument", c.Param("argument"), &argument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWithReferences(c.Writer, c.Request, globalArgument, argument)
+}
+
+// GetWithContentType operation middleware
+func (siw *GinServerInterfaceWrapper) GetWithContentType(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "content_type" -------------
+	var contentType GetWithContentTypeParamsContentType
+
+	err = runtime.BindStyledParameter("simple", false, "content_type", c.Param("content_type"), &contentType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter content_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWithContentType(c.Writer, c.Request, contentType)
+}
+
+// GetReservedKeyword operation middleware
+func (siw *GinServerInterfaceWrapper) GetReservedKeyword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReservedKeyword(c.Writer, c.Request)
+}
+
+// CreateResource operation middleware
+func (siw *GinServerInterfaceWrapper) CreateResource(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameter("simple", false, "argument", c.Param("argument"), &argument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateResource(c.Writer, c.Request, argument)
+}
+
+// CreateResource2 operation middleware
+func (siw *GinServerInterfaceWrapper) CreateResource2(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "inline_argument" -------------
+	var inlineArgument int
+
+	err = runtime.BindStyledParameter("simple", false, "inline_argument", c.Param("inline_argument"), &inlineArgument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter inline_argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResource2Params
+
+	// ------------- Optional query parameter "inline_query_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", c.Request.URL.Query(), &params.InlineQueryArgument)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter inline_query_argument: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateResource2(c.Writer, c.Request, inlineArgument, params)
+}
+
+// UpdateResource3 operation middleware
+func (siw *GinServerInterfaceWrapper) UpdateResource3(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "fallthrough" -------------
+	var pFallthrough int
+
+	err = runtime.BindStyledParameter("simple", false, "fallthrough", c.Param("fallthrough"), &pFallthrough)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fallthrough: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateResource3(c.Writer, c.Request, pFallthrough)
+}
+
+// GetResponseWithReference operation middleware
+func (siw *GinServerInterfaceWrapper) GetResponseWithReference(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetResponseWithReference(c.Writer, c.Request)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []GinMiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterGinHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterGinHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterGinHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterGinHandlersWithOptions creates http.Handler with additional options
+func RegisterGinHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := GinServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/every-type-optional", wrapper.GetEveryTypeOptional)
+	router.GET(options.BaseURL+"/get-simple", wrapper.GetSimple)
+	router.GET(options.BaseURL+"/get-with-args", wrapper.GetWithArgs)
+	router.GET(options.BaseURL+"/get-with-references/:global_argument/:argument", wrapper.GetWithReferences)
+	router.GET(options.BaseURL+"/get-with-type/:content_type", wrapper.GetWithContentType)
+	router.GET(options.BaseURL+"/reserved-keyword", wrapper.GetReservedKeyword)
+	router.POST(options.BaseURL+"/resource/:argument", wrapper.CreateResource)
+	router.POST(options.BaseURL+"/resource2/:inline_argument", wrapper.CreateResource2)
+	router.PUT(options.BaseURL+"/resource3/:fallthrough", wrapper.UpdateResource3)
+	router.GET(options.BaseURL+"/response-with-reference", wrapper.GetResponseWithReference)
+}
+
+// GorillaServerInterfaceWrapper converts contexts to parameters.
+type GorillaServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []GorillaMiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type GorillaMiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// GetEveryTypeOptional operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEveryTypeOptional(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSimple operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetSimple(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSimple(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetWithArgs operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWithArgsParams
+
+	// ------------- Optional query parameter "optional_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "optional_argument", r.URL.Query(), &params.OptionalArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "optional_argument", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "required_argument" -------------
+
+	if paramValue := r.URL.Query().Get("required_argument"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "required_argument"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "required_argument", r.URL.Query(), &params.RequiredArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "required_argument", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "header_argument" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header_argument")]; found {
+		var HeaderArgument int32
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "header_argument", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "header_argument", runtime.ParamLocationHeader, valueList[0], &HeaderArgument)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "header_argument", Err: err})
+			return
+		}
+
+		params.HeaderArgument = &HeaderArgument
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithArgs(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetWithReferences operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "global_argument" -------------
+	var globalArgument int64
+
+	err = runtime.BindStyledParameter("simple", false, "global_argument", mux.Vars(r)["global_argument"], &globalArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "global_argument", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameter("simple", false, "argument", mux.Vars(r)["argument"], &argument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "argument", Err: err})
+		return
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithReferences(w, r, globalArgument, argument)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetWithContentType operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "content_type" -------------
+	var contentType GetWithContentTypeParamsContentType
+
+	err = runtime.BindStyledParameter("simple", false, "content_type", mux.Vars(r)["content_type"], &contentType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "content_type", Err: err})
+		return
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWithContentType(w, r, contentType)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetReservedKeyword operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReservedKeyword(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateResource operation middleware
+func (siw *GorillaServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameter("simple", false, "argument", mux.Vars(r)["argument"], &argument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "argument", Err: err})
+		return
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResource(w, r, argument)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateResource2 operation middleware
+func (siw *GorillaServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "inline_argument" -------------
+	var inlineArgument int
+
+	err = runtime.BindStyledParameter("simple", false, "inline_argument", mux.Vars(r)["inline_argument"], &inlineArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inline_argument", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResource2Params
+
+	// ------------- Optional query parameter "inline_query_argument" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", r.URL.Query(), &params.InlineQueryArgument)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inline_query_argument", Err: err})
+		return
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResource2(w, r, inlineArgument, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateResource3 operation middleware
+func (siw *GorillaServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "fallthrough" -------------
+	var pFallthrough int
+
+	err = runtime.BindStyledParameter("simple", false, "fallthrough", mux.Vars(r)["fallthrough"], &pFallthrough)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fallthrough", Err: err})
+		return
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateResource3(w, r, pFallthrough)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetResponseWithReference operation middleware
+func (siw *GorillaServerInterfaceWrapper) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResponseWithReference(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func GorillaHandler(si ServerInterface) http.Handler {
+	return GorillaHandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []GorillaMiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func GorillaHandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return GorillaHandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func GorillaHandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return GorillaHandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func GorillaHandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := GorillaServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/every-type-optional", wrapper.GetEveryTypeOptional).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/get-simple", wrapper.GetSimple).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/get-with-args", wrapper.GetWithArgs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/get-with-type/{content_type}", wrapper.GetWithContentType).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/reserved-keyword", wrapper.GetReservedKeyword).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/resource/{argument}", wrapper.CreateResource).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/resource2/{inline_argument}", wrapper.CreateResource2).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/resource3/{fallthrough}", wrapper.UpdateResource3).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/response-with-reference", wrapper.GetResponseWithReference).Methods("GET")
+
+	return r
+}
+
+type ResponseWithReferenceJSONResponse SomeObject
+
+type SimpleResponseJSONResponse struct {
+	Name string `json:"name"`
+}
+
+type GetEveryTypeOptionalRequestObject struct {
+}
+
+type GetEveryTypeOptionalResponseObject interface {
+	VisitGetEveryTypeOptionalResponse(w http.ResponseWriter) error
+}
+
+type GetEveryTypeOptional200JSONResponse EveryTypeOptional
+
+func (response GetEveryTypeOptional200JSONResponse) VisitGetEveryTypeOptionalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSimpleRequestObject struct {
+}
+
+type GetSimpleResponseObject interface {
+	VisitGetSimpleResponse(w http.ResponseWriter) error
+}
+
+type GetSimple200JSONResponse SomeObject
+
+func (response GetSimple200JSONResponse) VisitGetSimpleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWithArgsRequestObject struct {
+	Params GetWithArgsParams
+}
+
+type GetWithArgsResponseObject interface {
+	VisitGetWithArgsResponse(w http.ResponseWriter) error
+}
+
+type GetWithArgs200JSONResponse struct{ SimpleResponseJSONResponse }
+
+func (response GetWithArgs200JSONResponse) VisitGetWithArgsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWithReferencesRequestObject struct {
+	GlobalArgument int64    `json:"global_argument"`
+	Argument       Argument `json:"argument"`
+}
+
+type GetWithReferencesResponseObject interface {
+	VisitGetWithReferencesResponse(w http.ResponseWriter) error
+}
+
+type GetWithReferences200JSONResponse struct{ SimpleResponseJSONResponse }
+
+func (response GetWithReferences200JSONResponse) VisitGetWithReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWithContentTypeRequestObject struct {
+	ContentType GetWithContentTypeParamsContentType `json:"content_type"`
+}
+
+type GetWithContentTypeResponseObject interface {
+	VisitGetWithContentTypeResponse(w http.ResponseWriter) error
+}
+
+type GetWithContentType200JSONResponse SomeObject
+
+func (response GetWithContentType200JSONResponse) VisitGetWithContentTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWithContentType200TextResponse string
+
+func (response GetWithContentType200TextResponse) VisitGetWithContentTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetReservedKeywordRequestObject struct {
+}
+
+type GetReservedKeywordResponseObject interface {
+	VisitGetReservedKeywordResponse(w http.ResponseWriter) error
+}
+
+type GetReservedKeyword200JSONResponse ReservedKeyword
+
+func (response GetReservedKeyword200JSONResponse) VisitGetReservedKeywordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResourceRequestObject struct {
+	Argument Argument `json:"argument"`
+	Body     *CreateResourceJSONRequestBody
+}
+
+type CreateResourceResponseObject interface {
+	VisitCreateResourceResponse(w http.ResponseWriter) error
+}
+
+type CreateResource200JSONResponse struct{ SimpleResponseJSONResponse }
+
+func (response CreateResource200JSONResponse) VisitCreateResourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateResource2RequestObject struct {
+	InlineArgument int `json:"inline_argument"`
+	Params         CreateResource2Params
+	Body           *CreateResource2JSONRequestBody
+}
+
+type CreateResource2ResponseObject interface {
+	VisitCreateResource2Response(w http.ResponseWriter) error
+}
+
+type CreateResource2200JSONResponse struct{ SimpleResponseJSONResponse }
+
+func (response CreateResource2200JSONResponse) VisitCreateResource2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateResource3RequestObject struct {
+	Fallthrough int `json:"fallthrough"`
+	Body        *UpdateResource3JSONRequestBody
+}
+
+type UpdateResource3ResponseObject interface {
+	VisitUpdateResource3Response(w http.ResponseWriter) error
+}
+
+type UpdateResource3200JSONResponse struct{ SimpleResponseJSONResponse }
+
+func (response UpdateResource3200JSONResponse) VisitUpdateResource3Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResponseWithReferenceRequestObject struct {
+}
+
+type GetResponseWithReferenceResponseObject interface {
+	VisitGetResponseWithReferenceResponse(w http.ResponseWriter) error
+}
+
+type GetResponseWithReference200JSONResponse struct {
+	ResponseWithReferenceJSONResponse
+}
+
+func (response GetResponseWithReference200JSONResponse) VisitGetResponseWithReferenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// get every type optional
+	// (GET /every-type-optional)
+	GetEveryTypeOptional(ctx context.Context, request GetEveryTypeOptionalRequestObject) (GetEveryTypeOptionalResponseObject, error)
+	// Get resource via simple path
+	// (GET /get-simple)
+	GetSimple(ctx context.Context, request GetSimpleRequestObject) (GetSimpleResponseObject, error)
+	// Getter with referenced parameter and referenced response
+	// (GET /get-with-args)
+	GetWithArgs(ctx context.Context, request GetWithArgsRequestObject) (GetWithArgsResponseObject, error)
+	// Getter with referenced parameter and referenced response
+	// (GET /get-with-references/{global_argument}/{argument})
+	GetWithReferences(ctx context.Context, request GetWithReferencesRequestObject) (GetWithReferencesResponseObject, error)
+	// Get an object by ID
+	// (GET /get-with-type/{content_type})
+	GetWithContentType(ctx context.Context, request GetWithContentTypeRequestObject) (GetWithContentTypeResponseObject, error)
+	// get with reserved keyword
+	// (GET /reserved-keyword)
+	GetReservedKeyword(ctx context.Context, request GetReservedKeywordRequestObject) (GetReservedKeywordResponseObject, error)
+	// Create a resource
+	// (POST /resource/{argument})
+	CreateResource(ctx context.Context, request CreateResourceRequestObject) (CreateResourceResponseObject, error)
+	// Create a resource with inline parameter
+	// (POST /resource2/{inline_argument})
+	CreateResource2(ctx context.Context, request CreateResource2RequestObject) (CreateResource2ResponseObject, error)
+	// Update a resource with inline body. The parameter name is a reserved
+	// keyword, so make sure that gets prefixed to avoid syntax errors
+	// (PUT /resource3/{fallthrough})
+	UpdateResource3(ctx context.Context, request UpdateResource3RequestObject) (UpdateResource3ResponseObject, error)
+	// get response with reference
+	// (GET /response-with-reference)
+	GetResponseWithReference(ctx context.Context, request GetResponseWithReferenceRequestObject) (GetResponseWithReferenceResponseObject, error)
+}
+
+type StrictHandlerFunc = runtime.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = runtime.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetEveryTypeOptional operation middleware
+func (sh *strictHandler) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
+	var request GetEveryTypeOptionalRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEveryTypeOptional(ctx, request.(GetEveryTypeOptionalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEveryTypeOptional")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEveryTypeOptionalResponseObject); ok {
+		if err := validResponse.VisitGetEveryTypeOptionalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetSimple operation middleware
+func (sh *strictHandler) GetSimple(w http.ResponseWriter, r *http.Request) {
+	var request GetSimpleRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSimple(ctx, request.(GetSimpleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSimple")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSimpleResponseObject); ok {
+		if err := validResponse.VisitGetSimpleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetWithArgs operation middleware
+func (sh *strictHandler) GetWithArgs(w http.ResponseWriter, r *http.Request, params GetWithArgsParams) {
+	var request GetWithArgsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWithArgs(ctx, request.(GetWithArgsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWithArgs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWithArgsResponseObject); ok {
+		if err := validResponse.VisitGetWithArgsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetWithReferences operation middleware
+func (sh *strictHandler) GetWithReferences(w http.ResponseWriter, r *http.Request, globalArgument int64, argument Argument) {
+	var request GetWithReferencesRequestObject
+
+	request.GlobalArgument = globalArgument
+	request.Argument = argument
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWithReferences(ctx, request.(GetWithReferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWithReferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWithReferencesResponseObject); ok {
+		if err := validResponse.VisitGetWithReferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetWithContentType operation middleware
+func (sh *strictHandler) GetWithContentType(w http.ResponseWriter, r *http.Request, contentType GetWithContentTypeParamsContentType) {
+	var request GetWithContentTypeRequestObject
+
+	request.ContentType = contentType
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWithContentType(ctx, request.(GetWithContentTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWithContentType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWithContentTypeResponseObject); ok {
+		if err := validResponse.VisitGetWithContentTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetReservedKeyword operation middleware
+func (sh *strictHandler) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
+	var request GetReservedKeywordRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReservedKeyword(ctx, request.(GetReservedKeywordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReservedKeyword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReservedKeywordResponseObject); ok {
+		if err := validResponse.VisitGetReservedKeywordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateResource operation middleware
+func (sh *strictHandler) CreateResource(w http.ResponseWriter, r *http.Request, argument Argument) {
+	var request CreateResourceRequestObject
+
+	request.Argument = argument
+
+	var body CreateResourceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateResource(ctx, request.(CreateResourceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateResource")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateResourceResponseObject); ok {
+		if err := validResponse.VisitCreateResourceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateResource2 operation middleware
+func (sh *strictHandler) CreateResource2(w http.ResponseWriter, r *http.Request, inlineArgument int, params CreateResource2Params) {
+	var request CreateResource2RequestObject
+
+	request.InlineArgument = inlineArgument
+	request.Params = params
+
+	var body CreateResource2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateResource2(ctx, request.(CreateResource2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateResource2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateResource2ResponseObject); ok {
+		if err := validResponse.VisitCreateResource2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// UpdateResource3 operation middleware
+func (sh *strictHandler) UpdateResource3(w http.ResponseWriter, r *http.Request, pFallthrough int) {
+	var request UpdateResource3RequestObject
+
+	request.Fallthrough = pFallthrough
+
+	var body UpdateResource3JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateResource3(ctx, request.(UpdateResource3RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateResource3")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateResource3ResponseObject); ok {
+		if err := validResponse.VisitUpdateResource3Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetResponseWithReference operation middleware
+func (sh *strictHandler) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
+	var request GetResponseWithReferenceRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetResponseWithReference(ctx, request.(GetResponseWithReferenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetResponseWithReference")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetResponseWithReferenceResponseObject); ok {
+		if err := validResponse.VisitGetResponseWithReferenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,82 @@
+package multipleservers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/mux"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server implements the ServerInterface shared by all the generated servers.
+type server struct {
+	Unimplemented
+}
+
+func (server) GetWithReferences(w http.ResponseWriter, r *http.Request, globalArgument int64, argument Argument) {
+	fmt.Fprintf(w, "%d/%s", globalArgument, argument)
+}
+
+// strictServer only implements the operation which the test invokes.
+type strictServer struct {
+	StrictServerInterface
+}
+
+func (strictServer) GetWithReferences(ctx context.Context, request GetWithReferencesRequestObject) (GetWithReferencesResponseObject, error) {
+	return GetWithReferences200JSONResponse{SimpleResponseJSONResponse{Name: fmt.Sprintf("%d/%s", request.GlobalArgument, request.Argument)}}, nil
+}
+
+func TestSharedServerInterface(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handlers := map[string]func(si ServerInterface) http.Handler{
+		"echo": func(si ServerInterface) http.Handler {
+			e := echo.New()
+			RegisterEchoHandlers(e, si)
+			return e
+		},
+		"chi": ChiHandler,
+		"gin": func(si ServerInterface) http.Handler {
+			r := gin.New()
+			RegisterGinHandlers(r, si)
+			return r
+		},
+		"gorilla": func(si ServerInterface) http.Handler {
+			return GorillaHandlerFromMux(si, mux.NewRouter())
+		},
+	}
+
+	get := func(t *testing.T, h http.Handler, path string) (int, string) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		body, err := io.ReadAll(rec.Body)
+		require.NoError(t, err)
+		return rec.Code, string(body)
+	}
+
+	for name, handler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			// The same implementation is served by every router.
+			h := handler(server{})
+			code, body := get(t, h, "/get-with-references/1/abc")
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "1/abc", body)
+
+			code, _ = get(t, h, "/get-simple")
+			assert.Equal(t, http.StatusNotImplemented, code)
+
+			// So is the strict handler.
+			h = handler(NewStrictHandler(strictServer{}, nil))
+			code, body = get(t, h, "/get-with-references/1/abc")
+			assert.Equal(t, http.StatusOK, code)
+			assert.JSONEq(t, `{"name": "1/abc"}`, body)
+		})
+	}
+}
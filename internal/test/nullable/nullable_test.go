@@ -0,0 +1,61 @@
+package nullable
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+func TestNullableJSON(t *testing.T) {
+	var patch PetPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"age": null, "owner": {}}`), &patch))
+	assert.True(t, patch.Age.IsNull())
+	assert.False(t, patch.Name.IsSpecified())
+	assert.True(t, patch.Owner.IsSpecified())
+
+	buf, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"age": null, "owner": {}}`, string(buf))
+
+	patch = PetPatch{Name: types.NewNullNullable[string](), Age: types.NewNullableWithValue(3)}
+	buf, err = json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"age": 3, "name": null}`, string(buf))
+}
+
+func TestNullableValidationAndDefaults(t *testing.T) {
+	patch := PetPatch{Name: types.NewNullNullable[string]()}
+	assert.NoError(t, patch.Validate())
+
+	patch.Name.Set("")
+	assert.EqualError(t, patch.Validate(), "/name: length must be at least 1")
+
+	patch.Owner.Set(Owner{})
+	patch.ApplyDefaults()
+	assert.Equal(t, "SE", *patch.Owner.MustGet().Country)
+}
+
+func TestNullableFormBody(t *testing.T) {
+	body := PatchPetFormdataRequestBody{
+		Name: types.NewNullNullable[string](),
+		Age:  types.NewNullableWithValue(3),
+	}
+	req, err := NewPatchPetRequestWithFormdataBody("https://example.com", 1, body)
+	require.NoError(t, err)
+	buf, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	values, err := url.ParseQuery(string(buf))
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"age": {"3"}, "name": {""}}, values)
+
+	var bound PetPatch
+	require.NoError(t, runtime.BindForm(&bound, values, nil, nil))
+	assert.Equal(t, body, bound)
+}
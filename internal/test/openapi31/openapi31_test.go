@@ -0,0 +1,24 @@
+package openapi31
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetJSON(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "kind": "pet", "name": "Rex", "tag": null, "owner": {"name": "Ann"}, "size": "small", "photo": "cGhvdG8="}`), &pet))
+	assert.True(t, pet.Kind.Valid())
+	assert.Nil(t, pet.Tag)
+	require.NotNil(t, pet.Owner)
+	assert.Equal(t, "Ann", *pet.Owner.Name)
+	assert.Equal(t, Small, *pet.Size)
+	assert.Equal(t, []byte("photo"), *pet.Photo)
+
+	buf, err := json.Marshal(Pet{Id: 2, Kind: PetKindPet, Name: "Tom"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 2, "kind": "pet", "name": "Tom", "owner": null, "size": null, "tag": null}`, string(buf))
+}
@@ -0,0 +1,965 @@
+// Package pagination provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:2403868a2ae62dec4a8c4b601a38bc3d43ba947ee50d5ba6a6bb0a8f8874e56c
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Defines values for PageDataKind.
+const (
+	Cat PageDataKind = "cat"
+	Dog PageDataKind = "dog"
+)
+
+// AllPageDataKindValues returns all the values of PageDataKind.
+func AllPageDataKindValues() []PageDataKind {
+	return []PageDataKind{
+		Cat,
+		Dog,
+	}
+}
+
+// Valid returns whether the value of the PageDataKind is one of the values of its enum.
+func (e PageDataKind) Valid() bool {
+	switch e {
+	case Cat, Dog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Page defines model for Page.
+type Page struct {
+	Data []struct {
+		Kind PageDataKind `json:"kind"`
+	} `json:"data"`
+}
+
+// PageDataKind defines model for Page.Data.Kind.
+type PageDataKind string
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string `json:"name"`
+}
+
+// ListPetsByCursorParams defines parameters for ListPetsByCursor.
+type ListPetsByCursorParams struct {
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPetsByOffsetParams defines parameters for ListPetsByOffset.
+type ListPetsByOffsetParams struct {
+	Offset *int  `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  int32 `form:"limit" json:"limit"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// RetryPolicy, when set, retries the requests of operations which may be
+	// retried: those with idempotent methods, unless overridden by the
+	// x-retryable extension of the operation.
+	RetryPolicy *runtime.RetryPolicy
+
+	// SecurityProviders, when set, apply credentials to the requests of
+	// operations according to their security requirements, by the name of
+	// their security scheme.
+	SecurityProviders map[string]RequestEditorFn
+
+	// RequestSigners, when set, sign the requests of operations whose
+	// security requirements include their security scheme, once the request
+	// editors have been applied.
+	RequestSigners map[string]RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRetryPolicy allows retrying the requests of operations which failed
+// transiently, such as with runtime.DefaultRetryPolicy().
+func WithRetryPolicy(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithSecurityProviders allows applying credentials to the requests of each
+// operation according to its security requirements, with the providers of the
+// security schemes they are made of, such as:
+//
+//	WithSecurityProviders(map[string]RequestEditorFn{
+//		"bearerAuth": bearerProvider.Intercept,
+//		"apiKey":     apiKeyProvider.Intercept,
+//	})
+//
+// The first requirement of an operation whose schemes all have a provider is
+// satisfied, and operations without security requirements, such as those
+// declaring "security: []", are sent without credentials.
+func WithSecurityProviders(providers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.SecurityProviders == nil {
+			c.SecurityProviders = make(map[string]RequestEditorFn, len(providers))
+		}
+		for name, provider := range providers {
+			c.SecurityProviders[name] = provider
+		}
+		return nil
+	}
+}
+
+// WithRequestSigners allows signing the requests of each operation according
+// to its security requirements, such as with the Intercept method of a
+// securityprovider.SecurityProviderHTTPSignature. Signers satisfy the security
+// schemes they're given for like security providers do, but since signatures
+// cover the request as it is sent, they're applied last, after the security
+// providers and the request editors, including those of the call.
+func WithRequestSigners(signers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.RequestSigners == nil {
+			c.RequestSigners = make(map[string]RequestEditorFn, len(signers))
+		}
+		for name, signer := range signers {
+			c.RequestSigners[name] = signer
+		}
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListPetsByCursor request
+	ListPetsByCursor(ctx context.Context, params *ListPetsByCursorParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListItems request
+	ListItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPetsByOffset request
+	ListPetsByOffset(ctx context.Context, params *ListPetsByOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOwnerPets request
+	ListOwnerPets(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListPetsByCursor(ctx context.Context, params *ListPetsByCursorParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPetsByCursorRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListItemsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListPetsByOffset(ctx context.Context, params *ListPetsByOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPetsByOffsetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListOwnerPets(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOwnerPetsRequest(c.Server, owner)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+// NewListPetsByCursorRequest generates requests for ListPetsByCursor
+func NewListPetsByCursorRequest(server string, params *ListPetsByCursorParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cursor/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListItemsRequest generates requests for ListItems
+func NewListItemsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPetsByOffsetRequest generates requests for ListPetsByOffset
+func NewListPetsByOffsetRequest(server string, params *ListPetsByOffsetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/offset/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOwnerPetsRequest generates requests for ListOwnerPets
+func NewListOwnerPetsRequest(server string, owner string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/owners/%s/pets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// do sends req, retrying it according to the retry policy of c when the
+// operation is retryable.
+func (c *Client) do(req *http.Request, retryable bool) (*http.Response, error) {
+	if c.RetryPolicy == nil {
+		return c.Client.Do(req)
+	}
+	return c.RetryPolicy.Do(c.Client, req, retryable)
+}
+
+// applySecurity applies the credentials of the first of the security
+// requirements of an operation whose security schemes all have a provider in
+// c.SecurityProviders or a signer in c.RequestSigners, and returns the signers
+// of the requirement, which sign the request once it's complete. Requirements
+// whose providers fail are skipped, and no credentials are applied when the
+// operation has no requirements.
+func (c *Client) applySecurity(ctx context.Context, req *http.Request, requirements [][]string) ([]RequestEditorFn, error) {
+	if (c.SecurityProviders == nil && c.RequestSigners == nil) || len(requirements) == 0 {
+		return nil, nil
+	}
+	var lastErr error
+	for _, schemes := range requirements {
+		providers := make([]RequestEditorFn, 0, len(schemes))
+		var signers []RequestEditorFn
+		for _, scheme := range schemes {
+			if provider, ok := c.SecurityProviders[scheme]; ok {
+				providers = append(providers, provider)
+			} else if signer, ok := c.RequestSigners[scheme]; ok {
+				signers = append(signers, signer)
+			}
+		}
+		if len(providers)+len(signers) != len(schemes) {
+			continue
+		}
+		attempt := req.Clone(ctx)
+		err := func() error {
+			for _, provider := range providers {
+				if err := provider(ctx, attempt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err == nil {
+			*req = *attempt
+			return signers, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("no security providers for the security requirements of %s %s", req.Method, req.URL.Path)
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListPetsByCursorWithResponse request
+	ListPetsByCursorWithResponse(ctx context.Context, params *ListPetsByCursorParams, reqEditors ...RequestEditorFn) (*ListPetsByCursorResponse, error)
+
+	// ListItemsWithResponse request
+	ListItemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListItemsResponse, error)
+
+	// ListPetsByOffsetWithResponse request
+	ListPetsByOffsetWithResponse(ctx context.Context, params *ListPetsByOffsetParams, reqEditors ...RequestEditorFn) (*ListPetsByOffsetResponse, error)
+
+	// ListOwnerPetsWithResponse request
+	ListOwnerPetsWithResponse(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*ListOwnerPetsResponse, error)
+}
+
+type ListPetsByCursorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data []Pet `json:"data"`
+		Meta *struct {
+			Next *string `json:"next,omitempty"`
+		} `json:"meta,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsByCursorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsByCursorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Page
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPetsByOffsetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsByOffsetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsByOffsetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOwnerPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOwnerPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOwnerPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListPetsByCursorWithResponse request returning *ListPetsByCursorResponse
+func (c *ClientWithResponses) ListPetsByCursorWithResponse(ctx context.Context, params *ListPetsByCursorParams, reqEditors ...RequestEditorFn) (*ListPetsByCursorResponse, error) {
+	rsp, err := c.ListPetsByCursor(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPetsByCursorResponse(rsp)
+}
+
+// ListPetsByCursorItems returns an iterator over the items of all the pages of
+// ListPetsByCursor, which are fetched as needed.
+func (c *ClientWithResponses) ListPetsByCursorItems(ctx context.Context, params *ListPetsByCursorParams, reqEditors ...RequestEditorFn) *runtime.PageIterator[Pet] {
+	var pageParams ListPetsByCursorParams
+	if params != nil {
+		pageParams = *params
+	}
+	return runtime.NewPageIterator(ctx, func(ctx context.Context) ([]Pet, bool, error) {
+		response, err := c.ListPetsByCursorWithResponse(ctx, &pageParams, reqEditors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if response.StatusCode()/100 != 2 {
+			return nil, false, fmt.Errorf("ListPetsByCursor: unexpected response status %s", response.Status())
+		}
+		var items []Pet
+		if err := runtime.UnmarshalJSONPath(response.Body, "data", &items); err != nil {
+			return nil, false, fmt.Errorf("ListPetsByCursor: can't unmarshal items: %w", err)
+		}
+
+		var next *string
+		if err := runtime.UnmarshalJSONPath(response.Body, "meta.next", &next); err != nil {
+			return nil, false, fmt.Errorf("ListPetsByCursor: can't unmarshal next cursor: %w", err)
+		}
+		if next == nil || *next == "" {
+			return items, false, nil
+		}
+		pageParams.Cursor = next
+		return items, true, nil
+	})
+}
+
+// ListItemsWithResponse request returning *ListItemsResponse
+func (c *ClientWithResponses) ListItemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListItemsResponse, error) {
+	rsp, err := c.ListItems(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemsResponse(rsp)
+}
+
+// ListItemsItems returns an iterator over the items of all the pages of
+// ListItems, which are fetched as needed by following the next links of
+// their Link header.
+func (c *ClientWithResponses) ListItemsItems(ctx context.Context, reqEditors ...RequestEditorFn) *runtime.PageIterator[struct {
+	Kind PageDataKind `json:"kind"`
+}] {
+	var linkEditors []RequestEditorFn
+	return runtime.NewPageIterator(ctx, func(ctx context.Context) ([]struct {
+		Kind PageDataKind `json:"kind"`
+	}, bool, error) {
+		editors := append(append([]RequestEditorFn{}, reqEditors...), linkEditors...)
+		response, err := c.ListItemsWithResponse(ctx, editors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if response.StatusCode()/100 != 2 {
+			return nil, false, fmt.Errorf("ListItems: unexpected response status %s", response.Status())
+		}
+		var items []struct {
+			Kind PageDataKind `json:"kind"`
+		}
+		if err := runtime.UnmarshalJSONPath(response.Body, "data", &items); err != nil {
+			return nil, false, fmt.Errorf("ListItems: can't unmarshal items: %w", err)
+		}
+
+		next, err := runtime.NextLink(response.HTTPResponse)
+		if err != nil || next == nil {
+			return items, false, err
+		}
+		linkEditors = []RequestEditorFn{func(ctx context.Context, req *http.Request) error {
+			req.URL = next
+			req.Host = next.Host
+			return nil
+		}}
+		return items, true, nil
+	})
+}
+
+// ListPetsByOffsetWithResponse request returning *ListPetsByOffsetResponse
+func (c *ClientWithResponses) ListPetsByOffsetWithResponse(ctx context.Context, params *ListPetsByOffsetParams, reqEditors ...RequestEditorFn) (*ListPetsByOffsetResponse, error) {
+	rsp, err := c.ListPetsByOffset(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPetsByOffsetResponse(rsp)
+}
+
+// ListPetsByOffsetItems returns an iterator over the items of all the pages of
+// ListPetsByOffset, which are fetched as needed.
+func (c *ClientWithResponses) ListPetsByOffsetItems(ctx context.Context, params *ListPetsByOffsetParams, reqEditors ...RequestEditorFn) *runtime.PageIterator[Pet] {
+	var pageParams ListPetsByOffsetParams
+	if params != nil {
+		pageParams = *params
+	}
+	return runtime.NewPageIterator(ctx, func(ctx context.Context) ([]Pet, bool, error) {
+		response, err := c.ListPetsByOffsetWithResponse(ctx, &pageParams, reqEditors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if response.StatusCode()/100 != 2 {
+			return nil, false, fmt.Errorf("ListPetsByOffset: unexpected response status %s", response.Status())
+		}
+		var items []Pet
+		if err := runtime.UnmarshalJSONPath(response.Body, "", &items); err != nil {
+			return nil, false, fmt.Errorf("ListPetsByOffset: can't unmarshal items: %w", err)
+		}
+
+		offset := 0
+		if pageParams.Offset != nil {
+			offset = int(*pageParams.Offset)
+		}
+		next := int(offset + len(items))
+		pageParams.Offset = &next
+		if len(items) < int(pageParams.Limit) {
+			return items, false, nil
+		}
+		return items, len(items) > 0, nil
+	})
+}
+
+// ListOwnerPetsWithResponse request returning *ListOwnerPetsResponse
+func (c *ClientWithResponses) ListOwnerPetsWithResponse(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*ListOwnerPetsResponse, error) {
+	rsp, err := c.ListOwnerPets(ctx, owner, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOwnerPetsResponse(rsp)
+}
+
+// ListOwnerPetsItems returns an iterator over the items of all the pages of
+// ListOwnerPets, which are fetched as needed by following the next links of
+// their Link header.
+func (c *ClientWithResponses) ListOwnerPetsItems(ctx context.Context, owner string, reqEditors ...RequestEditorFn) *runtime.PageIterator[string] {
+	var linkEditors []RequestEditorFn
+	return runtime.NewPageIterator(ctx, func(ctx context.Context) ([]string, bool, error) {
+		editors := append(append([]RequestEditorFn{}, reqEditors...), linkEditors...)
+		response, err := c.ListOwnerPetsWithResponse(ctx, owner, editors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if response.StatusCode()/100 != 2 {
+			return nil, false, fmt.Errorf("ListOwnerPets: unexpected response status %s", response.Status())
+		}
+		var items []string
+		if err := runtime.UnmarshalJSONPath(response.Body, "", &items); err != nil {
+			return nil, false, fmt.Errorf("ListOwnerPets: can't unmarshal items: %w", err)
+		}
+
+		next, err := runtime.NextLink(response.HTTPResponse)
+		if err != nil || next == nil {
+			return items, false, err
+		}
+		linkEditors = []RequestEditorFn{func(ctx context.Context, req *http.Request) error {
+			req.URL = next
+			req.Host = next.Host
+			return nil
+		}}
+		return items, true, nil
+	})
+}
+
+// ParseListPetsByCursorResponse parses an HTTP response from a ListPetsByCursorWithResponse call
+func ParseListPetsByCursorResponse(rsp *http.Response) (*ListPetsByCursorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsByCursorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data []Pet `json:"data"`
+			Meta *struct {
+				Next *string `json:"next,omitempty"`
+			} `json:"meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListItemsResponse parses an HTTP response from a ListItemsWithResponse call
+func ParseListItemsResponse(rsp *http.Response) (*ListItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Page
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPetsByOffsetResponse parses an HTTP response from a ListPetsByOffsetWithResponse call
+func ParseListPetsByOffsetResponse(rsp *http.Response) (*ListPetsByOffsetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsByOffsetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOwnerPetsResponse parses an HTTP response from a ListOwnerPetsWithResponse call
+func ParseListOwnerPetsResponse(rsp *http.Response) (*ListOwnerPetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOwnerPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,124 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagination(t *testing.T) {
+	pets := []string{"Tom", "Rex", "Kitty", "Max", "Bella"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cursor/pets", func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		end := start + 2
+		next := ""
+		if end < len(pets) {
+			next = strconv.Itoa(end)
+		} else {
+			end = len(pets)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[`)
+		for i, name := range pets[start:end] {
+			if i > 0 {
+				_, _ = fmt.Fprint(w, ",")
+			}
+			_, _ = fmt.Fprintf(w, `{"name":%q}`, name)
+		}
+		_, _ = fmt.Fprintf(w, `],"meta":{"next":%q}}`, next)
+	})
+	mux.HandleFunc("/offset/pets", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := offset + limit
+		if end > len(pets) {
+			end = len(pets)
+		}
+		_, _ = fmt.Fprint(w, "[")
+		for i, name := range pets[offset:end] {
+			if i > 0 {
+				_, _ = fmt.Fprint(w, ",")
+			}
+			_, _ = fmt.Fprintf(w, `{"name":%q}`, name)
+		}
+		_, _ = fmt.Fprint(w, "]")
+	})
+	mux.HandleFunc("/owners/alice/pets", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 2 {
+			w.Header().Set("Link", fmt.Sprintf(`</owners/alice/pets?page=%d>; rel="next"`, page+1))
+		}
+		_, _ = fmt.Fprintf(w, `[%q]`, pets[page])
+	})
+	mux.HandleFunc("/items", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `</items?page=2>; rel="next"`)
+			_, _ = fmt.Fprint(w, `{"data":[{"kind":"cat"}]}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data":[{"kind":"dog"}]}`)
+	})
+	mux.HandleFunc("/owners/mallory/pets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://elsewhere.example.com/owners/mallory/pets?page=1>; rel="next"`)
+		_, _ = fmt.Fprintf(w, `[%q]`, pets[0])
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var names []string
+	cursorPets := client.ListPetsByCursorItems(ctx, nil)
+	for cursorPets.Next() {
+		names = append(names, cursorPets.Item().Name)
+	}
+	require.NoError(t, cursorPets.Err())
+	assert.Equal(t, pets, names)
+
+	names = nil
+	offsetPets := client.ListPetsByOffsetItems(ctx, &ListPetsByOffsetParams{Limit: 2})
+	for offsetPets.Next() {
+		names = append(names, offsetPets.Item().Name)
+	}
+	require.NoError(t, offsetPets.Err())
+	assert.Equal(t, pets, names)
+
+	names = nil
+	ownerPets := client.ListOwnerPetsItems(ctx, "alice")
+	for ownerPets.Next() {
+		names = append(names, ownerPets.Item())
+	}
+	require.NoError(t, ownerPets.Err())
+	assert.Equal(t, pets[:3], names)
+
+	// The items of schemas of the components have the types of their
+	// models.
+	var kinds []PageDataKind
+	items := client.ListItemsItems(ctx)
+	for items.Next() {
+		kinds = append(kinds, items.Item().Kind)
+	}
+	require.NoError(t, items.Err())
+	assert.Equal(t, []PageDataKind{Cat, Dog}, kinds)
+
+	// Links to other servers aren't followed, which would send them the
+	// credentials of the client.
+	malloryPets := client.ListOwnerPetsItems(ctx, "mallory")
+	assert.False(t, malloryPets.Next())
+	assert.ErrorContains(t, malloryPets.Err(), "isn't on the origin of the request")
+
+	// Errors stop the iteration.
+	failing, err := NewClientWithResponses(server.URL + "/missing")
+	require.NoError(t, err)
+	failingPets := failing.ListOwnerPetsItems(ctx, "alice")
+	assert.False(t, failingPets.Next())
+	assert.EqualError(t, failingPets.Err(), "ListOwnerPets: unexpected response status 404 Not Found")
+}
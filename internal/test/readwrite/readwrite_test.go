@@ -0,0 +1,89 @@
+package readwrite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	users []UserResponse
+}
+
+func (s *server) CreateTeam(ctx context.Context, request CreateTeamRequestObject) (CreateTeamResponseObject, error) {
+	team := TeamResponse{Name: request.Body.Name}
+	return CreateTeam201JSONResponse(team), nil
+}
+
+func (s *server) ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error) {
+	return ListUsers200JSONResponse(s.users), nil
+}
+
+func (s *server) CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error) {
+	if request.Body.Password == "" {
+		return nil, assert.AnError
+	}
+	user := UserResponse{
+		Id:   int64(len(s.users) + 1),
+		Name: request.Body.Name,
+		Role: request.Body.Role,
+	}
+	s.users = append(s.users, user)
+	return CreateUser201JSONResponse(user), nil
+}
+
+func (s *server) UpdateProfile(ctx context.Context, request UpdateProfileRequestObject) (UpdateProfileResponseObject, error) {
+	views := 42
+	return UpdateProfile200JSONResponse{ProfileResultJSONResponse{Bio: request.Body.Profile.Bio, Views: &views}}, nil
+}
+
+func TestVariantsOmitProperties(t *testing.T) {
+	buf, err := json.Marshal(UserRequest{Name: "alice", Password: "secret123", Role: UserRoleAdmin})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "alice", "password": "secret123", "role": "admin"}`, string(buf))
+
+	buf, err = json.Marshal(UserResponse{Id: 1, Name: "alice", Role: UserRoleAdmin})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1, "name": "alice", "role": "admin"}`, string(buf))
+
+	// Required properties which are only sent in one direction are
+	// validated in their variant.
+	assert.Error(t, UserRequest{Name: "alice", Password: "short", Role: UserRoleAdmin}.Validate())
+	assert.NoError(t, User{Name: "alice", Role: UserRoleAdmin}.Validate())
+}
+
+func TestVariantsRoundTrip(t *testing.T) {
+	srv := httptest.NewServer(Handler(NewStrictHandler(&server{}, nil)))
+	defer srv.Close()
+
+	client, err := NewClientWithResponses(srv.URL)
+	require.NoError(t, err)
+
+	created, err := client.CreateUserWithResponse(context.Background(), CreateUserJSONRequestBody{Name: "alice", Password: "secret123", Role: UserRoleMember})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	assert.Equal(t, UserResponse{Id: 1, Name: "alice", Role: UserRoleMember}, *created.JSON201)
+	assert.NotContains(t, string(created.Body), "password")
+
+	users, err := client.ListUsersWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []UserResponse{*created.JSON201}, *users.JSON200)
+
+	bio := "hello"
+	profile, err := client.UpdateProfileWithResponse(context.Background(), 1, UpdateProfileJSONRequestBody{Profile: ProfileRequest{Bio: &bio}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, profile.StatusCode())
+	assert.Equal(t, 42, *profile.JSON200.Views)
+
+	team, err := client.CreateTeamWithResponse(context.Background(), CreateTeamJSONRequestBody{
+		Name:    "team",
+		Members: &[]MemberRequest{{Name: "bob", Password: "secret123", Role: UserRoleMember}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "team", team.JSON201.Name)
+}
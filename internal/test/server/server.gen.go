@@ -1,6 +1,7 @@
 // Package server provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:aa42b63dab37f2bf9ace7cf49ad7c8dedf62cc1a2ad6c4f12bd32d0483b904e6
 package server
 
 import (
@@ -19,6 +20,24 @@ const (
 	Text GetWithContentTypeParamsContentType = "text"
 )
 
+// AllGetWithContentTypeParamsContentTypeValues returns all the values of GetWithContentTypeParamsContentType.
+func AllGetWithContentTypeParamsContentTypeValues() []GetWithContentTypeParamsContentType {
+	return []GetWithContentTypeParamsContentType{
+		Text,
+		Json,
+	}
+}
+
+// Valid returns whether the value of the GetWithContentTypeParamsContentType is one of the values of its enum.
+func (e GetWithContentTypeParamsContentType) Valid() bool {
+	switch e {
+	case Text, Json:
+		return true
+	default:
+		return false
+	}
+}
+
 // EveryTypeOptional defines model for EveryTypeOptional.
 type EveryTypeOptional struct {
 	ArrayInlineField     *[]int              `json:"array_inline_field,omitempty"`
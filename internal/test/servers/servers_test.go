@@ -0,0 +1,76 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDoer struct {
+	urls []string
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.urls = append(d.urls, req.URL.String())
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func TestServerURLs(t *testing.T) {
+	assert.Equal(t, "https://us.api.example.com:8443/v1", ServerUrlProduction(ServerUrlProductionRegionUs, "8443"))
+	assert.Equal(t, "https://eu.api.example.com:443/v1", ServerUrlProduction("", ""))
+	assert.Equal(t, "http://localhost:8080", ServerUrlLocal(""))
+	assert.Equal(t, "https://staging.api.example.com/v1", ServerUrlStaging)
+	assert.Equal(t, "https://uploads.example.com/v2", UploadServerUrl(""))
+	assert.Equal(t, "https://uploads.example.com/v3", UploadServerUrl("v3"))
+	assert.Equal(t, "/relative", DownloadServerUrl)
+}
+
+func TestOperationServers(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient(ServerUrlProduction(ServerUrlProductionRegionApSoutheast, ""), WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background())
+	require.NoError(t, err)
+	_, err = client.UploadWithBody(context.Background(), "application/octet-stream", http.NoBody)
+	require.NoError(t, err)
+	// Relative servers of operations are resolved against the server of the
+	// client.
+	_, err = client.Download(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"https://ap-southeast.api.example.com:443/v1/things",
+		"https://uploads.example.com/v2/uploads",
+		"https://ap-southeast.api.example.com:443/relative/downloads",
+	}, doer.urls)
+}
+
+func TestOperationServerOptions(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient(ServerUrlStaging, WithHTTPClient(doer),
+		WithUploadServer(UploadServerUrl("v3")),
+		WithDownloadServer("mirror"))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background())
+	require.NoError(t, err)
+	_, err = client.UploadWithBody(context.Background(), "application/octet-stream", http.NoBody)
+	require.NoError(t, err)
+	_, err = client.Download(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"https://staging.api.example.com/v1/things",
+		"https://uploads.example.com/v3/uploads",
+		"https://staging.api.example.com/v1/mirror/downloads",
+	}, doer.urls)
+
+	client, err = NewClient(ServerUrlStaging, WithHTTPClient(doer), WithUploadServer("://invalid"))
+	require.NoError(t, err)
+	_, err = client.UploadWithBody(context.Background(), "application/octet-stream", http.NoBody)
+	assert.ErrorContains(t, err, "invalid server of Upload")
+}
@@ -0,0 +1,676 @@
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:368dba602701aafe66c9e122f9568d367033125f1dae144f759fc74acf5f5a53
+
+package splitoutput
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetEveryTypeOptionalWithResponse request
+	GetEveryTypeOptionalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEveryTypeOptionalResponse, error)
+
+	// GetSimpleWithResponse request
+	GetSimpleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSimpleResponse, error)
+
+	// GetWithArgsWithResponse request
+	GetWithArgsWithResponse(ctx context.Context, params *GetWithArgsParams, reqEditors ...RequestEditorFn) (*GetWithArgsResponse, error)
+
+	// GetWithReferencesWithResponse request
+	GetWithReferencesWithResponse(ctx context.Context, globalArgument int64, argument Argument, reqEditors ...RequestEditorFn) (*GetWithReferencesResponse, error)
+
+	// GetWithContentTypeWithResponse request
+	GetWithContentTypeWithResponse(ctx context.Context, contentType GetWithContentTypeParamsContentType, reqEditors ...RequestEditorFn) (*GetWithContentTypeResponse, error)
+
+	// GetReservedKeywordWithResponse request
+	GetReservedKeywordWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReservedKeywordResponse, error)
+
+	// CreateResourceWithBodyWithResponse request with any body
+	CreateResourceWithBodyWithResponse(ctx context.Context, argument Argument, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error)
+
+	CreateResourceWithResponse(ctx context.Context, argument Argument, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error)
+
+	// CreateResource2WithBodyWithResponse request with any body
+	CreateResource2WithBodyWithResponse(ctx context.Context, inlineArgument int, params *CreateResource2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResource2Response, error)
+
+	CreateResource2WithResponse(ctx context.Context, inlineArgument int, params *CreateResource2Params, body CreateResource2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResource2Response, error)
+
+	// UpdateResource3WithBodyWithResponse request with any body
+	UpdateResource3WithBodyWithResponse(ctx context.Context, pFallthrough int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResource3Response, error)
+
+	UpdateResource3WithResponse(ctx context.Context, pFallthrough int, body UpdateResource3JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResource3Response, error)
+
+	// GetResponseWithReferenceWithResponse request
+	GetResponseWithReferenceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponseWithReferenceResponse, error)
+}
+
+type GetEveryTypeOptionalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EveryTypeOptional
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEveryTypeOptionalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEveryTypeOptionalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSimpleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SomeObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSimpleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimpleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWithArgsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimpleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithArgsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithArgsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWithReferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimpleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithReferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithReferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWithContentTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SomeObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithContentTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithContentTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReservedKeywordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReservedKeyword
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReservedKeywordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReservedKeywordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateResourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimpleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateResource2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimpleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResource2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResource2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateResource3Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimpleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateResource3Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateResource3Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResponseWithReferenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponseWithReference
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResponseWithReferenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponseWithReferenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetEveryTypeOptionalWithResponse request returning *GetEveryTypeOptionalResponse
+func (c *ClientWithResponses) GetEveryTypeOptionalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEveryTypeOptionalResponse, error) {
+	rsp, err := c.GetEveryTypeOptional(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEveryTypeOptionalResponse(rsp)
+}
+
+// GetSimpleWithResponse request returning *GetSimpleResponse
+func (c *ClientWithResponses) GetSimpleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSimpleResponse, error) {
+	rsp, err := c.GetSimple(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimpleResponse(rsp)
+}
+
+// GetWithArgsWithResponse request returning *GetWithArgsResponse
+func (c *ClientWithResponses) GetWithArgsWithResponse(ctx context.Context, params *GetWithArgsParams, reqEditors ...RequestEditorFn) (*GetWithArgsResponse, error) {
+	rsp, err := c.GetWithArgs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithArgsResponse(rsp)
+}
+
+// GetWithReferencesWithResponse request returning *GetWithReferencesResponse
+func (c *ClientWithResponses) GetWithReferencesWithResponse(ctx context.Context, globalArgument int64, argument Argument, reqEditors ...RequestEditorFn) (*GetWithReferencesResponse, error) {
+	rsp, err := c.GetWithReferences(ctx, globalArgument, argument, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithReferencesResponse(rsp)
+}
+
+// GetWithContentTypeWithResponse request returning *GetWithContentTypeResponse
+func (c *ClientWithResponses) GetWithContentTypeWithResponse(ctx context.Context, contentType GetWithContentTypeParamsContentType, reqEditors ...RequestEditorFn) (*GetWithContentTypeResponse, error) {
+	rsp, err := c.GetWithContentType(ctx, contentType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithContentTypeResponse(rsp)
+}
+
+// GetReservedKeywordWithResponse request returning *GetReservedKeywordResponse
+func (c *ClientWithResponses) GetReservedKeywordWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReservedKeywordResponse, error) {
+	rsp, err := c.GetReservedKeyword(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReservedKeywordResponse(rsp)
+}
+
+// CreateResourceWithBodyWithResponse request with arbitrary body returning *CreateResourceResponse
+func (c *ClientWithResponses) CreateResourceWithBodyWithResponse(ctx context.Context, argument Argument, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error) {
+	rsp, err := c.CreateResourceWithBody(ctx, argument, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResourceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateResourceWithResponse(ctx context.Context, argument Argument, body CreateResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResourceResponse, error) {
+	rsp, err := c.CreateResource(ctx, argument, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResourceResponse(rsp)
+}
+
+// CreateResource2WithBodyWithResponse request with arbitrary body returning *CreateResource2Response
+func (c *ClientWithResponses) CreateResource2WithBodyWithResponse(ctx context.Context, inlineArgument int, params *CreateResource2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResource2Response, error) {
+	rsp, err := c.CreateResource2WithBody(ctx, inlineArgument, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResource2Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateResource2WithResponse(ctx context.Context, inlineArgument int, params *CreateResource2Params, body CreateResource2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResource2Response, error) {
+	rsp, err := c.CreateResource2(ctx, inlineArgument, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResource2Response(rsp)
+}
+
+// UpdateResource3WithBodyWithResponse request with arbitrary body returning *UpdateResource3Response
+func (c *ClientWithResponses) UpdateResource3WithBodyWithResponse(ctx context.Context, pFallthrough int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResource3Response, error) {
+	rsp, err := c.UpdateResource3WithBody(ctx, pFallthrough, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateResource3Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateResource3WithResponse(ctx context.Context, pFallthrough int, body UpdateResource3JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResource3Response, error) {
+	rsp, err := c.UpdateResource3(ctx, pFallthrough, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateResource3Response(rsp)
+}
+
+// GetResponseWithReferenceWithResponse request returning *GetResponseWithReferenceResponse
+func (c *ClientWithResponses) GetResponseWithReferenceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponseWithReferenceResponse, error) {
+	rsp, err := c.GetResponseWithReference(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponseWithReferenceResponse(rsp)
+}
+
+// ParseGetEveryTypeOptionalResponse parses an HTTP response from a GetEveryTypeOptionalWithResponse call
+func ParseGetEveryTypeOptionalResponse(rsp *http.Response) (*GetEveryTypeOptionalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEveryTypeOptionalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EveryTypeOptional
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSimpleResponse parses an HTTP response from a GetSimpleWithResponse call
+func ParseGetSimpleResponse(rsp *http.Response) (*GetSimpleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSimpleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SomeObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWithArgsResponse parses an HTTP response from a GetWithArgsWithResponse call
+func ParseGetWithArgsResponse(rsp *http.Response) (*GetWithArgsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithArgsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimpleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWithReferencesResponse parses an HTTP response from a GetWithReferencesWithResponse call
+func ParseGetWithReferencesResponse(rsp *http.Response) (*GetWithReferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithReferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimpleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWithContentTypeResponse parses an HTTP response from a GetWithContentTypeWithResponse call
+func ParseGetWithContentTypeResponse(rsp *http.Response) (*GetWithContentTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithContentTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SomeObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReservedKeywordResponse parses an HTTP response from a GetReservedKeywordWithResponse call
+func ParseGetReservedKeywordResponse(rsp *http.Response) (*GetReservedKeywordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReservedKeywordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservedKeyword
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateResourceResponse parses an HTTP response from a CreateResourceWithResponse call
+func ParseCreateResourceResponse(rsp *http.Response) (*CreateResourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimpleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateResource2Response parses an HTTP response from a CreateResource2WithResponse call
+func ParseCreateResource2Response(rsp *http.Response) (*CreateResource2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResource2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimpleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateResource3Response parses an HTTP response from a UpdateResource3WithResponse call
+func ParseUpdateResource3Response(rsp *http.Response) (*UpdateResource3Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateResource3Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimpleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResponseWithReferenceResponse parses an HTTP response from a GetResponseWithReferenceWithResponse call
+func ParseGetResponseWithReferenceResponse(rsp *http.Response) (*GetResponseWithReferenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResponseWithReferenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponseWithReference
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
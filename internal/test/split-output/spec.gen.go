@@ -0,0 +1,116 @@
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:368dba602701aafe66c9e122f9568d367033125f1dae144f759fc74acf5f5a53
+
+package splitoutput
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xZTW8bNxP+KwTf97jSOnaQg25uWgRGURSwXfSQCAKlHUmMd0ma5DoWBP33Ysj94O5S",
+	"H67lIAVys8iZ4cwzzwyH6y1dyEJJAcIaOtlSxTQrwIJ2v5helQUIi39nYBaaK8uloBN6JwsgzXZCOS4q",
+	"Ztc0oYIVQCc02NXwWHINGZ1YXUJCzWINBUOrdqNQ1ljNxYrudjsUNkoKA86D2+rH39yub2EJGsQCcGMh",
+	"ha08Y0rlfMHQs/SrQfe2wQn/17CkE/q/tA009bsmNbKAmZx/hYX1Z3eDvCa1L8QLkW/crgkj6DXRjTu7",
+	"hN7xQuVQe/siB5WWCrTlPmAP3hCYEMTPXmoaddk4T/qeOwtV2Gj+tyfQm/uNgj+dJsuHnjCt2WbGRc4F",
+	"zJYc8gxXuYXCBA5yYWEFGjGoVpwe/vYGGpiyiJGTkzO0Ppcyby1Wu7gITLj9jQ3cXkpdMIsSGws06cOb",
+	"0IxFxTN2QNzyYp/OCPeiirKc51Ett9GqiLKYe1yXuWQ2ouHWYwpVzjx2reJJREtqO5EUx0jYyE/dwfbq",
+	"MuKpW6fJwKDT+PA+rvHh/T6NQdqDbe/NQKIFJ8bHF9DQ4zSwHzawprZuG7B+1tYPWltQMJ5HNNx67Iyf",
+	"xfgfKsYuRCEg3WDDQHo06ma8F1KnUnrudGg/JHWcGBFEkliz2NsAOrWJLLgFA/oJst9h803qCPUWayYE",
+	"5Mfhc7uVSVnqBQxt7aXxE8tLOKVe4qz26nh2SIDXz05cLCUq5HwB1djmbdA/bu5dY+Q2x5/3YCy5QyA1",
+	"ugPa+HHr3fhifIGCUoFgitMJvRpfjN/RxA3DzqsU8D4YoVMjGUxbK3AhYABuNLzJ6IR+AjsczXoz8eXF",
+	"xdkm4OFhkaGSEXhmbqqUS+Ki8ROwn4YFqaMiPk9owJRFwfSGTjDMUEe25yQ0XYEd+YH1ECJ+uH5LGI4+",
+	"BPpT9VJqwupVTHUv6k9gia7qhDzxoagLHQEcMb0yh6LHp881yiSd19nn/pPsOkjEY4mA999nbrV9oNXS",
+	"s0Cuheto998lr/agLsvZSW/FN/FoDSwD3brkfx+HJHqF7qZxjsao18ilvbfjgEgWtC+1ttuThgmEiSzc",
+	"0I2ZnpWGRh3yNZom3a5yOQ/YsEu3zZ/H+Nk8zSMsjQXfiqQN0j8YeIdrLbDBBfHIueImZiEVxL+I9AB+",
+	"JdmnkQQHeeikGZXTbdUqZ/jraEo/emG8G451Hmx21ZeRLrRFmVvu7o3SqtK6G8DEsQl9OwgMiLJw4wg8",
+	"I4Kuz08Hg/o+Mr3BbZE4V1KVM96zEXkbnuNewRvXHU7mG3Lzq79OdDXnjR7aQW9fevsz4RtC1T8qAkIw",
+	"W7AWiIpPdVjkoTHQHy6q6o7IpfUN3OtkSpoIMB81MAvNcPvKPvZYgrG/yGxz/lGt+awQAbPeI3M8ul9H",
+	"u7N3WA+aT5THrQP8Zbqt3i0vTsDlsa5zwifo3tmnfIk+MEy4A0+aa6pz3Wp8lIhPDucnzW2blgFX6pHf",
+	"c+U7cMOXqgenvSm6jLlKt0uW53atZblae7aUEbL8pbKALFdnIEtw7MuI8orUdd+vfN9nlD3v2khOa7dr",
+	"lL9TG/DZ2JdqdGJM7tdB0gnGRLgJWvwXUfXuhBhJCvYAxJQaiF0zS1ZgDVEalvwZMmIlYU+SZ8RshGXP",
+	"BLSW2nwRDZWcj70J98iNGPk307+BKW5peGt1LzodSKIoAlLxuNQ5ndC1tWqSptVnBgvGjjMAVTA1Zpzu",
+	"prt/BgARII1fyRsAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,143 @@
+// Package splitoutput provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:368dba602701aafe66c9e122f9568d367033125f1dae144f759fc74acf5f5a53
+package splitoutput
+
+import (
+	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// Defines values for GetWithContentTypeParamsContentType.
+const (
+	Json GetWithContentTypeParamsContentType = "json"
+	Text GetWithContentTypeParamsContentType = "text"
+)
+
+// AllGetWithContentTypeParamsContentTypeValues returns all the values of GetWithContentTypeParamsContentType.
+func AllGetWithContentTypeParamsContentTypeValues() []GetWithContentTypeParamsContentType {
+	return []GetWithContentTypeParamsContentType{
+		Text,
+		Json,
+	}
+}
+
+// Valid returns whether the value of the GetWithContentTypeParamsContentType is one of the values of its enum.
+func (e GetWithContentTypeParamsContentType) Valid() bool {
+	switch e {
+	case Text, Json:
+		return true
+	default:
+		return false
+	}
+}
+
+// EveryTypeOptional defines model for EveryTypeOptional.
+type EveryTypeOptional struct {
+	ArrayInlineField     *[]int              `json:"array_inline_field,omitempty"`
+	ArrayReferencedField *[]SomeObject       `json:"array_referenced_field,omitempty"`
+	BoolField            *bool               `json:"bool_field,omitempty"`
+	ByteField            *[]byte             `json:"byte_field,omitempty"`
+	DateField            *openapi_types.Date `json:"date_field,omitempty"`
+	DateTimeField        *time.Time          `json:"date_time_field,omitempty"`
+	DoubleField          *float64            `json:"double_field,omitempty"`
+	FloatField           *float32            `json:"float_field,omitempty"`
+	InlineObjectField    *struct {
+		Name   string `json:"name"`
+		Number int    `json:"number"`
+	} `json:"inline_object_field,omitempty"`
+	Int32Field      *int32      `json:"int32_field,omitempty"`
+	Int64Field      *int64      `json:"int64_field,omitempty"`
+	IntField        *int        `json:"int_field,omitempty"`
+	NumberField     *float32    `json:"number_field,omitempty"`
+	ReferencedField *SomeObject `json:"referenced_field,omitempty"`
+	StringField     *string     `json:"string_field,omitempty"`
+}
+
+// EveryTypeRequired defines model for EveryTypeRequired.
+type EveryTypeRequired struct {
+	ArrayInlineField     []int                `json:"array_inline_field"`
+	ArrayReferencedField []SomeObject         `json:"array_referenced_field"`
+	BoolField            bool                 `json:"bool_field"`
+	ByteField            []byte               `json:"byte_field"`
+	DateField            openapi_types.Date   `json:"date_field"`
+	DateTimeField        time.Time            `json:"date_time_field"`
+	DoubleField          float64              `json:"double_field"`
+	EmailField           *openapi_types.Email `json:"email_field,omitempty"`
+	FloatField           float32              `json:"float_field"`
+	InlineObjectField    struct {
+		Name   string `json:"name"`
+		Number int    `json:"number"`
+	} `json:"inline_object_field"`
+	Int32Field      int32      `json:"int32_field"`
+	Int64Field      int64      `json:"int64_field"`
+	IntField        int        `json:"int_field"`
+	NumberField     float32    `json:"number_field"`
+	ReferencedField SomeObject `json:"referenced_field"`
+	StringField     string     `json:"string_field"`
+}
+
+// ReservedKeyword defines model for ReservedKeyword.
+type ReservedKeyword struct {
+	Channel *string `json:"channel,omitempty"`
+}
+
+// Resource defines model for Resource.
+type Resource struct {
+	Name  string  `json:"name"`
+	Value float32 `json:"value"`
+}
+
+// SomeObject defines model for some_object.
+type SomeObject struct {
+	Name string `json:"name"`
+}
+
+// Argument defines model for argument.
+type Argument = string
+
+// ResponseWithReference defines model for ResponseWithReference.
+type ResponseWithReference = SomeObject
+
+// SimpleResponse defines model for SimpleResponse.
+type SimpleResponse struct {
+	Name string `json:"name"`
+}
+
+// GetWithArgsParams defines parameters for GetWithArgs.
+type GetWithArgsParams struct {
+	// OptionalArgument An optional query argument
+	OptionalArgument *int64 `form:"optional_argument,omitempty" json:"optional_argument,omitempty"`
+
+	// RequiredArgument An optional query argument
+	RequiredArgument int64 `form:"required_argument" json:"required_argument"`
+
+	// HeaderArgument An optional query argument
+	HeaderArgument *int32 `json:"header_argument,omitempty"`
+}
+
+// GetWithContentTypeParamsContentType defines parameters for GetWithContentType.
+type GetWithContentTypeParamsContentType string
+
+// CreateResource2Params defines parameters for CreateResource2.
+type CreateResource2Params struct {
+	// InlineQueryArgument Some query argument
+	InlineQueryArgument *int `form:"inline_query_argument,omitempty" json:"inline_query_argument,omitempty"`
+}
+
+// UpdateResource3JSONBody defines parameters for UpdateResource3.
+type UpdateResource3JSONBody struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
+type CreateResourceJSONRequestBody = EveryTypeRequired
+
+// CreateResource2JSONRequestBody defines body for CreateResource2 for application/json ContentType.
+type CreateResource2JSONRequestBody = Resource
+
+// UpdateResource3JSONRequestBody defines body for UpdateResource3 for application/json ContentType.
+type UpdateResource3JSONRequestBody UpdateResource3JSONBody
@@ -0,0 +1,1251 @@
+// Package streaming provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:980512f9236be2fd333e7d7eb4cfb52e77b99e403213787c312b6f2cfcfac96e
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name    string  `json:"name"`
+	Species *string `json:"species,omitempty"`
+}
+
+// ExportPetsParams defines parameters for ExportPets.
+type ExportPetsParams struct {
+	Species *string `form:"species,omitempty" json:"species,omitempty"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// RetryPolicy, when set, retries the requests of operations which may be
+	// retried: those with idempotent methods, unless overridden by the
+	// x-retryable extension of the operation.
+	RetryPolicy *runtime.RetryPolicy
+
+	// SecurityProviders, when set, apply credentials to the requests of
+	// operations according to their security requirements, by the name of
+	// their security scheme.
+	SecurityProviders map[string]RequestEditorFn
+
+	// RequestSigners, when set, sign the requests of operations whose
+	// security requirements include their security scheme, once the request
+	// editors have been applied.
+	RequestSigners map[string]RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRetryPolicy allows retrying the requests of operations which failed
+// transiently, such as with runtime.DefaultRetryPolicy().
+func WithRetryPolicy(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithSecurityProviders allows applying credentials to the requests of each
+// operation according to its security requirements, with the providers of the
+// security schemes they are made of, such as:
+//
+//	WithSecurityProviders(map[string]RequestEditorFn{
+//		"bearerAuth": bearerProvider.Intercept,
+//		"apiKey":     apiKeyProvider.Intercept,
+//	})
+//
+// The first requirement of an operation whose schemes all have a provider is
+// satisfied, and operations without security requirements, such as those
+// declaring "security: []", are sent without credentials.
+func WithSecurityProviders(providers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.SecurityProviders == nil {
+			c.SecurityProviders = make(map[string]RequestEditorFn, len(providers))
+		}
+		for name, provider := range providers {
+			c.SecurityProviders[name] = provider
+		}
+		return nil
+	}
+}
+
+// WithRequestSigners allows signing the requests of each operation according
+// to its security requirements, such as with the Intercept method of a
+// securityprovider.SecurityProviderHTTPSignature. Signers satisfy the security
+// schemes they're given for like security providers do, but since signatures
+// cover the request as it is sent, they're applied last, after the security
+// providers and the request editors, including those of the call.
+func WithRequestSigners(signers map[string]RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		if c.RequestSigners == nil {
+			c.RequestSigners = make(map[string]RequestEditorFn, len(signers))
+		}
+		for name, signer := range signers {
+			c.RequestSigners[name] = signer
+		}
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// TailLogs request
+	TailLogs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchPets request
+	WatchPets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPets request
+	ExportPets(ctx context.Context, params *ExportPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) TailLogs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTailLogsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) WatchPets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchPetsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ExportPets(ctx context.Context, params *ExportPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	signers, err := c.applySecurity(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	// Signers come last, since signatures cover the request as the editors
+	// leave it.
+	for _, signer := range signers {
+		if err := signer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+// NewTailLogsRequest generates requests for TailLogs
+func NewTailLogsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWatchPetsRequest generates requests for WatchPets
+func NewWatchPetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPetsRequest generates requests for ExportPets
+func NewExportPetsRequest(server string, params *ExportPetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Species != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "species", runtime.ParamLocationQuery, *params.Species); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// do sends req, retrying it according to the retry policy of c when the
+// operation is retryable.
+func (c *Client) do(req *http.Request, retryable bool) (*http.Response, error) {
+	if c.RetryPolicy == nil {
+		return c.Client.Do(req)
+	}
+	return c.RetryPolicy.Do(c.Client, req, retryable)
+}
+
+// applySecurity applies the credentials of the first of the security
+// requirements of an operation whose security schemes all have a provider in
+// c.SecurityProviders or a signer in c.RequestSigners, and returns the signers
+// of the requirement, which sign the request once it's complete. Requirements
+// whose providers fail are skipped, and no credentials are applied when the
+// operation has no requirements.
+func (c *Client) applySecurity(ctx context.Context, req *http.Request, requirements [][]string) ([]RequestEditorFn, error) {
+	if (c.SecurityProviders == nil && c.RequestSigners == nil) || len(requirements) == 0 {
+		return nil, nil
+	}
+	var lastErr error
+	for _, schemes := range requirements {
+		providers := make([]RequestEditorFn, 0, len(schemes))
+		var signers []RequestEditorFn
+		for _, scheme := range schemes {
+			if provider, ok := c.SecurityProviders[scheme]; ok {
+				providers = append(providers, provider)
+			} else if signer, ok := c.RequestSigners[scheme]; ok {
+				signers = append(signers, signer)
+			}
+		}
+		if len(providers)+len(signers) != len(schemes) {
+			continue
+		}
+		attempt := req.Clone(ctx)
+		err := func() error {
+			for _, provider := range providers {
+				if err := provider(ctx, attempt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		if err == nil {
+			*req = *attempt
+			return signers, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("no security providers for the security requirements of %s %s", req.Method, req.URL.Path)
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// TailLogsWithResponse request
+	TailLogsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TailLogsResponse, error)
+
+	// WatchPetsWithResponse request
+	WatchPetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WatchPetsResponse, error)
+
+	// ExportPetsWithResponse request
+	ExportPetsWithResponse(ctx context.Context, params *ExportPetsParams, reqEditors ...RequestEditorFn) (*ExportPetsResponse, error)
+}
+
+type TailLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TailLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TailLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// TailLogsWithResponse request returning *TailLogsResponse
+func (c *ClientWithResponses) TailLogsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TailLogsResponse, error) {
+	rsp, err := c.TailLogs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTailLogsResponse(rsp)
+}
+
+// TailLogsEventStream performs the TailLogs request, and returns a reader of
+// the text/event-stream stream of its successful response, which decodes the
+// values as they are received. The reader must be closed.
+func (c *ClientWithResponses) TailLogsEventStream(ctx context.Context, reqEditors ...RequestEditorFn) (*runtime.EventStreamReader[string], error) {
+	editors := append([]RequestEditorFn{func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "text/event-stream")
+		return nil
+	}}, reqEditors...)
+	rsp, err := c.TailLogs(ctx, editors...)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode/100 != 2 {
+		response, err := ParseTailLogsResponse(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("TailLogs: unexpected response status %s", response.Status())
+	}
+	if contentType := rsp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/event-stream") {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("TailLogs: unexpected content type %q", contentType)
+	}
+	return runtime.NewEventStreamReader[string](rsp.Body), nil
+}
+
+// WatchPetsWithResponse request returning *WatchPetsResponse
+func (c *ClientWithResponses) WatchPetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WatchPetsResponse, error) {
+	rsp, err := c.WatchPets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchPetsResponse(rsp)
+}
+
+// WatchPetsEventStream performs the WatchPets request, and returns a reader of
+// the text/event-stream stream of its successful response, which decodes the
+// values as they are received. The reader must be closed.
+func (c *ClientWithResponses) WatchPetsEventStream(ctx context.Context, reqEditors ...RequestEditorFn) (*runtime.EventStreamReader[Pet], error) {
+	editors := append([]RequestEditorFn{func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "text/event-stream")
+		return nil
+	}}, reqEditors...)
+	rsp, err := c.WatchPets(ctx, editors...)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode/100 != 2 {
+		response, err := ParseWatchPetsResponse(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("WatchPets: unexpected response status %s", response.Status())
+	}
+	if contentType := rsp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/event-stream") {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("WatchPets: unexpected content type %q", contentType)
+	}
+	return runtime.NewEventStreamReader[Pet](rsp.Body), nil
+}
+
+// ExportPetsWithResponse request returning *ExportPetsResponse
+func (c *ClientWithResponses) ExportPetsWithResponse(ctx context.Context, params *ExportPetsParams, reqEditors ...RequestEditorFn) (*ExportPetsResponse, error) {
+	rsp, err := c.ExportPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPetsResponse(rsp)
+}
+
+// ExportPetsNDJSON performs the ExportPets request, and returns a reader of
+// the application/x-ndjson stream of its successful response, which decodes the
+// values as they are received. The reader must be closed.
+func (c *ClientWithResponses) ExportPetsNDJSON(ctx context.Context, params *ExportPetsParams, reqEditors ...RequestEditorFn) (*runtime.NDJSONReader[Pet], error) {
+	editors := append([]RequestEditorFn{func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "application/x-ndjson")
+		return nil
+	}}, reqEditors...)
+	rsp, err := c.ExportPets(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode/100 != 2 {
+		response, err := ParseExportPetsResponse(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("ExportPets: unexpected response status %s", response.Status())
+	}
+	if contentType := rsp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/x-ndjson") {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("ExportPets: unexpected content type %q", contentType)
+	}
+	return runtime.NewNDJSONReader[Pet](rsp.Body), nil
+}
+
+// ParseTailLogsResponse parses an HTTP response from a TailLogsWithResponse call
+func ParseTailLogsResponse(rsp *http.Response) (*TailLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TailLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseWatchPetsResponse parses an HTTP response from a WatchPetsWithResponse call
+func ParseWatchPetsResponse(rsp *http.Response) (*WatchPetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WatchPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPetsResponse parses an HTTP response from a ExportPetsWithResponse call
+func ParseExportPetsResponse(rsp *http.Response) (*ExportPetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /logs)
+	TailLogs(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets/events)
+	WatchPets(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets/export)
+	ExportPets(w http.ResponseWriter, r *http.Request, params ExportPetsParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /logs)
+func (_ Unimplemented) TailLogs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /pets/events)
+func (_ Unimplemented) WatchPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /pets/export)
+func (_ Unimplemented) ExportPets(w http.ResponseWriter, r *http.Request, params ExportPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// TailLogs operation middleware
+func (siw *ServerInterfaceWrapper) TailLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TailLogs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// WatchPets operation middleware
+func (siw *ServerInterfaceWrapper) WatchPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchPets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportPets operation middleware
+func (siw *ServerInterfaceWrapper) ExportPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportPetsParams
+
+	// ------------- Optional query parameter "species" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "species", r.URL.Query(), &params.Species)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "species", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPets(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// ServeMux is an abstraction of http.ServeMux, on which the handlers are
+// registered with the method and wildcard patterns of Go 1.22.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/logs", wrapper.TailLogs)
+
+	m.HandleFunc("GET "+options.BaseURL+"/pets/events", wrapper.WatchPets)
+
+	m.HandleFunc("GET "+options.BaseURL+"/pets/export", wrapper.ExportPets)
+
+	return m
+}
+
+type LogsEventStreamResponse struct {
+	Body func(w *runtime.EventStreamWriter[string]) error
+}
+
+type TailLogsRequestObject struct {
+}
+
+type TailLogsResponseObject interface {
+	VisitTailLogsResponse(w http.ResponseWriter) error
+}
+
+// TailLogsStreamingResponseObject is implemented by the streaming responses of TailLogs, which are written until ctx is done.
+type TailLogsStreamingResponseObject interface {
+	StreamTailLogsResponse(ctx context.Context, w http.ResponseWriter) error
+}
+
+type TailLogs200EventStreamResponse struct{ LogsEventStreamResponse }
+
+func (response TailLogs200EventStreamResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
+	return response.StreamTailLogsResponse(context.Background(), w)
+}
+
+func (response TailLogs200EventStreamResponse) StreamTailLogsResponse(ctx context.Context, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+	if response.Body == nil {
+		return nil
+	}
+	if err := response.Body(runtime.NewEventStreamWriter[string](ctx, w)); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+type WatchPetsRequestObject struct {
+}
+
+type WatchPetsResponseObject interface {
+	VisitWatchPetsResponse(w http.ResponseWriter) error
+}
+
+// WatchPetsStreamingResponseObject is implemented by the streaming responses of WatchPets, which are written until ctx is done.
+type WatchPetsStreamingResponseObject interface {
+	StreamWatchPetsResponse(ctx context.Context, w http.ResponseWriter) error
+}
+
+type WatchPets200EventStreamResponse struct {
+	Body func(w *runtime.EventStreamWriter[Pet]) error
+}
+
+func (response WatchPets200EventStreamResponse) VisitWatchPetsResponse(w http.ResponseWriter) error {
+	return response.StreamWatchPetsResponse(context.Background(), w)
+}
+
+func (response WatchPets200EventStreamResponse) StreamWatchPetsResponse(ctx context.Context, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+	if response.Body == nil {
+		return nil
+	}
+	if err := response.Body(runtime.NewEventStreamWriter[Pet](ctx, w)); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+type WatchPetsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response WatchPetsdefaultJSONResponse) VisitWatchPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportPetsRequestObject struct {
+	Params ExportPetsParams
+}
+
+type ExportPetsResponseObject interface {
+	VisitExportPetsResponse(w http.ResponseWriter) error
+}
+
+// ExportPetsStreamingResponseObject is implemented by the streaming responses of ExportPets, which are written until ctx is done.
+type ExportPetsStreamingResponseObject interface {
+	StreamExportPetsResponse(ctx context.Context, w http.ResponseWriter) error
+}
+
+type ExportPets200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ExportPets200NDJSONResponse struct {
+	Body    func(w *runtime.NDJSONWriter[Pet]) error
+	Headers ExportPets200ResponseHeaders
+}
+
+func (response ExportPets200NDJSONResponse) VisitExportPetsResponse(w http.ResponseWriter) error {
+	return response.StreamExportPetsResponse(context.Background(), w)
+}
+
+func (response ExportPets200NDJSONResponse) StreamExportPetsResponse(ctx context.Context, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+	if response.Body == nil {
+		return nil
+	}
+	if err := response.Body(runtime.NewNDJSONWriter[Pet](ctx, w)); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /logs)
+	TailLogs(ctx context.Context, request TailLogsRequestObject) (TailLogsResponseObject, error)
+
+	// (GET /pets/events)
+	WatchPets(ctx context.Context, request WatchPetsRequestObject) (WatchPetsResponseObject, error)
+
+	// (GET /pets/export)
+	ExportPets(ctx context.Context, request ExportPetsRequestObject) (ExportPetsResponseObject, error)
+}
+
+type StrictHandlerFunc = runtime.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = runtime.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// TailLogs operation middleware
+func (sh *strictHandler) TailLogs(w http.ResponseWriter, r *http.Request) {
+	var request TailLogsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TailLogs(ctx, request.(TailLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TailLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TailLogsResponseObject); ok {
+		if streamingResponse, ok := response.(TailLogsStreamingResponseObject); ok {
+			if err := streamingResponse.StreamTailLogsResponse(r.Context(), w); err != nil {
+				sh.options.ResponseErrorHandlerFunc(w, r, err)
+			}
+		} else if err := validResponse.VisitTailLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// WatchPets operation middleware
+func (sh *strictHandler) WatchPets(w http.ResponseWriter, r *http.Request) {
+	var request WatchPetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchPets(ctx, request.(WatchPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchPets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchPetsResponseObject); ok {
+		if streamingResponse, ok := response.(WatchPetsStreamingResponseObject); ok {
+			if err := streamingResponse.StreamWatchPetsResponse(r.Context(), w); err != nil {
+				sh.options.ResponseErrorHandlerFunc(w, r, err)
+			}
+		} else if err := validResponse.VisitWatchPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ExportPets operation middleware
+func (sh *strictHandler) ExportPets(w http.ResponseWriter, r *http.Request, params ExportPetsParams) {
+	var request ExportPetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportPets(ctx, request.(ExportPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportPets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportPetsResponseObject); ok {
+		if streamingResponse, ok := response.(ExportPetsStreamingResponseObject); ok {
+			if err := streamingResponse.StreamExportPetsResponse(r.Context(), w); err != nil {
+				sh.options.ResponseErrorHandlerFunc(w, r, err)
+			}
+		} else if err := validResponse.VisitExportPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// MockServer implements StrictServerInterface by responding with the examples
+// of the spec, or with samples derived from the schemas of the responses. The
+// response is selected from the Prefer and Accept headers of the request, when
+// the handler is wrapped with runtime.MockPreferencesMiddleware.
+type MockServer struct{}
+
+var _ StrictServerInterface = MockServer{}
+
+func (MockServer) TailLogs(ctx context.Context, request TailLogsRequestObject) (TailLogsResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "200", ContentType: "text/event-stream", Examples: []runtime.MockExample{
+			{Value: "\"string\""},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response TailLogs200EventStreamResponse
+		var value string
+		if err := json.Unmarshal([]byte(selection.Example), &value); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of TailLogs: %w", err)
+		}
+		response.LogsEventStreamResponse.Body = func(w *runtime.EventStreamWriter[string]) error { return w.Write(value) }
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of TailLogs at index %d", selection.Index)
+}
+
+func (MockServer) WatchPets(ctx context.Context, request WatchPetsRequestObject) (WatchPetsResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "200", ContentType: "text/event-stream", Examples: []runtime.MockExample{
+			{Value: "{\"name\":\"Tom\"}"},
+		}},
+		{StatusCode: "default", ContentType: "application/json", Examples: []runtime.MockExample{
+			{Value: "{\"message\":\"string\"}"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response WatchPets200EventStreamResponse
+		var value Pet
+		if err := json.Unmarshal([]byte(selection.Example), &value); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of WatchPets: %w", err)
+		}
+		response.Body = func(w *runtime.EventStreamWriter[Pet]) error { return w.Write(value) }
+		return response, nil
+	case 1:
+		var response WatchPetsdefaultJSONResponse
+		if err := json.Unmarshal([]byte(selection.Example), &response.Body); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of WatchPets: %w", err)
+		}
+		response.StatusCode = selection.StatusCode
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of WatchPets at index %d", selection.Index)
+}
+
+func (MockServer) ExportPets(ctx context.Context, request ExportPetsRequestObject) (ExportPetsResponseObject, error) {
+	selection, err := runtime.SelectMockResponse(ctx, []runtime.MockResponse{
+		{StatusCode: "200", ContentType: "application/x-ndjson", Examples: []runtime.MockExample{
+			{Value: "{\"name\":\"string\",\"species\":\"string\"}"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch selection.Index {
+	case 0:
+		var response ExportPets200NDJSONResponse
+		var value Pet
+		if err := json.Unmarshal([]byte(selection.Example), &value); err != nil {
+			return nil, fmt.Errorf("can't unmarshal example of ExportPets: %w", err)
+		}
+		response.Body = func(w *runtime.NDJSONWriter[Pet]) error { return w.Write(value) }
+		return response, nil
+	}
+	return nil, fmt.Errorf("no response of ExportPets at index %d", selection.Index)
+}
@@ -0,0 +1,104 @@
+// The std-http server needs the ServeMux patterns of Go 1.22, which modules
+// with an older go directive, like this one, only get by asking for them.
+//go:debug httpmuxgo121=0
+
+package streaming
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+type streamingServer struct {
+	watchDone chan error
+}
+
+func (s *streamingServer) WatchPets(ctx context.Context, request WatchPetsRequestObject) (WatchPetsResponseObject, error) {
+	return WatchPets200EventStreamResponse{Body: func(w *runtime.EventStreamWriter[Pet]) error {
+		var err error
+		defer func() { s.watchDone <- err }()
+		for i := 0; err == nil; i++ {
+			err = w.Write(Pet{Name: "Tom", Species: &[]string{"cat", "dog"}[i%2]})
+			time.Sleep(time.Millisecond)
+		}
+		return err
+	}}, nil
+}
+
+func (s *streamingServer) ExportPets(ctx context.Context, request ExportPetsRequestObject) (ExportPetsResponseObject, error) {
+	return ExportPets200NDJSONResponse{
+		Headers: ExportPets200ResponseHeaders{XTotalCount: 2},
+		Body: func(w *runtime.NDJSONWriter[Pet]) error {
+			for _, name := range []string{"Tom", "Rex"} {
+				if err := w.Write(Pet{Name: name, Species: request.Params.Species}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func (s *streamingServer) TailLogs(ctx context.Context, request TailLogsRequestObject) (TailLogsResponseObject, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestStreaming(t *testing.T) {
+	server := &streamingServer{watchDone: make(chan error, 1)}
+	httpServer := httptest.NewServer(Handler(NewStrictHandler(server, nil)))
+	defer httpServer.Close()
+	client, err := NewClientWithResponses(httpServer.URL)
+	require.NoError(t, err)
+
+	t.Run("server-sent events", func(t *testing.T) {
+		events, err := client.WatchPetsEventStream(context.Background())
+		require.NoError(t, err)
+		for _, species := range []string{"cat", "dog", "cat"} {
+			require.True(t, events.Next())
+			assert.Equal(t, Pet{Name: "Tom", Species: &species}, events.Item())
+		}
+		require.NoError(t, events.Close())
+
+		// The server stops writing once the client went away.
+		select {
+		case err := <-server.watchDone:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the server kept writing events")
+		}
+	})
+
+	t.Run("JSON lines", func(t *testing.T) {
+		species := "dog"
+		lines, err := client.ExportPetsNDJSON(context.Background(), &ExportPetsParams{Species: &species})
+		require.NoError(t, err)
+		defer lines.Close()
+		var pets []Pet
+		for lines.Next() {
+			pets = append(pets, lines.Item())
+		}
+		require.NoError(t, lines.Err())
+		assert.Equal(t, []Pet{{Name: "Tom", Species: &species}, {Name: "Rex", Species: &species}}, pets)
+	})
+
+	t.Run("unsuccessful responses", func(t *testing.T) {
+		_, err := client.TailLogsEventStream(context.Background())
+		assert.EqualError(t, err, "TailLogs: unexpected response status 500 Internal Server Error")
+	})
+}
+
+func TestMockServerStreaming(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler(NewStrictHandler(MockServer{}, nil)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/events", nil))
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "data: {\"name\":\"Tom\"}\n\n", rec.Body.String())
+}
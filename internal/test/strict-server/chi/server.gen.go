@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:3e40e2d99bb4640ed1415cb328fece46932c831ac9fc45e421b55c88ac74f7c5
 package api
 
 import (
@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:ab47df44ea943af471ba0304aaceadf396702dab146dc742306fb7bc1877256d
 package api
 
 import (
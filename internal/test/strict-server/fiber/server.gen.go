@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:a3fb616f21896f013d8e36399169b5a507b8d3c135ab2fa6341c15ccdcad8ffa
 package api
 
 import (
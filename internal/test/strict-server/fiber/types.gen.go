@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:13ca27202fdbfba460aaf766c0198eed1d3ba4949ff42f56cba9de666344f332
 package api
 
 // Example defines model for example.
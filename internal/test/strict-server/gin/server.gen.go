@@ -1,6 +1,7 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:1b1923863933c921e3c70dedd7e39ba9369e0da98c6f1f40aa34be182b742c48
 package api
 
 import (
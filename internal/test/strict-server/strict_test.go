@@ -1,3 +1,7 @@
+// The std-http server needs the ServeMux patterns of Go 1.22, which modules
+// with an older go directive, like this one, only get by asking for them.
+//go:debug httpmuxgo121=0
+
 package strictserver
 
 import (
@@ -23,6 +27,7 @@ import (
 	echoAPI "github.com/deepmap/oapi-codegen/internal/test/strict-server/echo"
 	fiberAPI "github.com/deepmap/oapi-codegen/internal/test/strict-server/fiber"
 	ginAPI "github.com/deepmap/oapi-codegen/internal/test/strict-server/gin"
+	stdhttpAPI "github.com/deepmap/oapi-codegen/internal/test/strict-server/stdhttp"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/deepmap/oapi-codegen/pkg/testutil"
 )
@@ -35,6 +40,14 @@ func TestChiServer(t *testing.T) {
 	testImpl(t, handler)
 }
 
+func TestStdHTTPServer(t *testing.T) {
+	server := stdhttpAPI.StrictServer{}
+	strictHandler := stdhttpAPI.NewStrictHandler(server, nil)
+	m := http.NewServeMux()
+	handler := stdhttpAPI.HandlerFromMux(strictHandler, m)
+	testImpl(t, handler)
+}
+
 func TestEchoServer(t *testing.T) {
 	server := echoAPI.StrictServer{}
 	strictHandler := echoAPI.NewStrictHandler(server, nil)
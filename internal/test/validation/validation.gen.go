@@ -0,0 +1,444 @@
+// Package validation provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+// Input hash: sha256:15254e2eb68114ee27f3d91cb01ba2d10b77752db2d9a066ecd8ce13b18d413c
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Defines values for Kind.
+const (
+	Cat Kind = "cat"
+	Dog Kind = "dog"
+)
+
+// AllKindValues returns all the values of Kind.
+func AllKindValues() []Kind {
+	return []Kind{
+		Cat,
+		Dog,
+	}
+}
+
+// Valid returns whether the value of the Kind is one of the values of its enum.
+func (e Kind) Valid() bool {
+	switch e {
+	case Cat, Dog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Circle defines model for Circle.
+type Circle struct {
+	Kind   string  `json:"kind"`
+	Radius float32 `json:"radius"`
+}
+
+// Counts defines model for Counts.
+type Counts map[string]int
+
+// Kind defines model for Kind.
+type Kind string
+
+// Number defines model for Number.
+type Number struct {
+	union json.RawMessage
+}
+
+// Number0 defines model for .
+type Number0 = int
+
+// Number1 defines model for .
+type Number1 = string
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age        *int               `json:"age,omitempty"`
+	Attributes *map[string]string `json:"attributes,omitempty"`
+	Counts     *Counts            `json:"counts,omitempty"`
+	Kind       Kind               `json:"kind"`
+	Name       string             `json:"name"`
+	Number     *Number            `json:"number,omitempty"`
+	Owner      *struct {
+		Email  *string `json:"email,omitempty"`
+		Handle *string `json:"handle,omitempty"`
+	} `json:"owner,omitempty"`
+	Shape  *Shape   `json:"shape,omitempty"`
+	Tags   []Tag    `json:"tags"`
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// Shape defines model for Shape.
+type Shape struct {
+	union json.RawMessage
+}
+
+// Square defines model for Square.
+type Square struct {
+	Kind string `json:"kind"`
+	Side int    `json:"side"`
+}
+
+// Tag defines model for Tag.
+type Tag = string
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Limit *int32  `form:"limit,omitempty" json:"limit,omitempty"`
+	Name  *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody struct {
+	Note *string `json:"note,omitempty"`
+	Pet  Pet     `json:"pet"`
+}
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// AsNumber0 returns the union data inside the Number as a Number0
+func (t Number) AsNumber0() (Number0, error) {
+	var body Number0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNumber0 overwrites any union data inside the Number as the provided Number0
+func (t *Number) FromNumber0(v Number0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNumber0 performs a merge with any union data inside the Number, using the provided Number0
+func (t *Number) MergeNumber0(v Number0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNumber1 returns the union data inside the Number as a Number1
+func (t Number) AsNumber1() (Number1, error) {
+	var body Number1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNumber1 overwrites any union data inside the Number as the provided Number1
+func (t *Number) FromNumber1(v Number1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNumber1 performs a merge with any union data inside the Number, using the provided Number1
+func (t *Number) MergeNumber1(v Number1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Number) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Number) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsCircle returns the union data inside the Shape as a Circle
+func (t Shape) AsCircle() (Circle, error) {
+	var body Circle
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCircle overwrites any union data inside the Shape as the provided Circle
+func (t *Shape) FromCircle(v Circle) error {
+	v.Kind = "circle"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCircle performs a merge with any union data inside the Shape, using the provided Circle
+func (t *Shape) MergeCircle(v Circle) error {
+	v.Kind = "circle"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSquare returns the union data inside the Shape as a Square
+func (t Shape) AsSquare() (Square, error) {
+	var body Square
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSquare overwrites any union data inside the Shape as the provided Square
+func (t *Shape) FromSquare(v Square) error {
+	v.Kind = "square"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSquare performs a merge with any union data inside the Shape, using the provided Square
+func (t *Shape) MergeSquare(v Square) error {
+	v.Kind = "square"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Shape) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Shape) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "circle":
+		return t.AsCircle()
+	case "square":
+		return t.AsSquare()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Shape) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Shape) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// Validate checks that the Circle satisfies the constraints of its schema.
+func (t Circle) Validate() error {
+	var errs runtime.ValidationErrors
+	if float64(t.Radius) <= 0 {
+		errs.Add("/radius", "must be greater than 0")
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Counts satisfies the constraints of its schema.
+func (t Counts) Validate() error {
+	var errs runtime.ValidationErrors
+	for k1, v1 := range t {
+		if float64(v1) > 5 {
+			errs.Add("/"+runtime.JSONPointerToken(k1), "must be less than or equal to 5")
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Kind satisfies the constraints of its schema.
+func (t Kind) Validate() error {
+	var errs runtime.ValidationErrors
+	switch t {
+	case "cat", "dog":
+	default:
+		errs.Add("", "must be one of [cat dog]")
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Number satisfies the constraints of its schema.
+func (t Number) Validate() error {
+	var errs runtime.ValidationErrors
+	{
+		matched := 0
+		if value, err := t.AsNumber0(); err == nil {
+			var errs runtime.ValidationErrors
+			if float64(value) < 10 {
+				errs.Add("", "must be greater than or equal to 10")
+			}
+			if len(errs) == 0 {
+				matched++
+			}
+		}
+		if value, err := t.AsNumber1(); err == nil {
+			var errs runtime.ValidationErrors
+			if utf8.RuneCountInString(value) > 2 {
+				errs.Add("", "length must be at most 2")
+			}
+			if len(errs) == 0 {
+				matched++
+			}
+		}
+		if matched == 0 {
+			errs.Add("", "must match a schema of anyOf")
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Pet satisfies the constraints of its schema.
+func (t Pet) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Age != nil {
+		if float64(*t.Age) < 0 {
+			errs.Add("/age", "must be greater than or equal to 0")
+		}
+		if float64(*t.Age) >= 50 {
+			errs.Add("/age", "must be less than 50")
+		}
+	}
+	if t.Attributes != nil {
+		for k1, v1 := range *t.Attributes {
+			if utf8.RuneCountInString(v1) < 2 {
+				errs.Add("/attributes/"+runtime.JSONPointerToken(k1), "length must be at least 2")
+			}
+		}
+	}
+	if t.Counts != nil {
+		errs.Merge("/counts", t.Counts.Validate())
+	}
+	errs.Merge("/kind", t.Kind.Validate())
+	if utf8.RuneCountInString(t.Name) < 1 {
+		errs.Add("/name", "length must be at least 1")
+	}
+	if utf8.RuneCountInString(t.Name) > 20 {
+		errs.Add("/name", "length must be at most 20")
+	}
+	if t.Number != nil {
+		errs.Merge("/number", t.Number.Validate())
+	}
+	if t.Owner != nil {
+		if t.Owner.Email != nil {
+			if !runtime.MatchesPattern("^[^@]+@[^@]+$", *t.Owner.Email) {
+				errs.Add("/owner/email", "must match pattern %s", "^[^@]+@[^@]+$")
+			}
+		}
+	}
+	if t.Shape != nil {
+		errs.Merge("/shape", t.Shape.Validate())
+	}
+	if t.Tags == nil {
+		errs.Add("/tags", "is required")
+	} else {
+		if len(t.Tags) < 1 {
+			errs.Add("/tags", "must have at least 1 items")
+		}
+		if len(t.Tags) > 3 {
+			errs.Add("/tags", "must have at most 3 items")
+		}
+		if !runtime.HasUniqueItems(t.Tags) {
+			errs.Add("/tags", "must have unique items")
+		}
+		for k1, v1 := range t.Tags {
+			if utf8.RuneCountInString(v1) < 2 {
+				errs.Add("/tags/"+strconv.Itoa(k1), "length must be at least 2")
+			}
+		}
+	}
+	if t.Weight != nil {
+		if !runtime.IsMultipleOf(float64(*t.Weight), 0.5) {
+			errs.Add("/weight", "must be a multiple of 0.5")
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Shape satisfies the constraints of its schema.
+func (t Shape) Validate() error {
+	var errs runtime.ValidationErrors
+	if value, err := t.ValueByDiscriminator(); err != nil {
+		errs.Add("", "%s", err)
+	} else {
+		errs.Merge("", runtime.Validate(value))
+	}
+	return errs.Err()
+}
+
+// Validate checks that the Square satisfies the constraints of its schema.
+func (t Square) Validate() error {
+	var errs runtime.ValidationErrors
+	if float64(t.Side) < 1 {
+		errs.Add("/side", "must be greater than or equal to 1")
+	}
+	return errs.Err()
+}
+
+// Validate checks that the FindPetsParams satisfies the constraints of its schema.
+func (t FindPetsParams) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Limit != nil {
+		if float64(*t.Limit) < 1 {
+			errs.Add("/limit", "must be greater than or equal to 1")
+		}
+		if float64(*t.Limit) > 100 {
+			errs.Add("/limit", "must be less than or equal to 100")
+		}
+	}
+	if t.Name != nil {
+		if !runtime.MatchesPattern("^[a-z]+$", *t.Name) {
+			errs.Add("/name", "must match pattern %s", "^[a-z]+$")
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks that the AddPetJSONBody satisfies the constraints of its schema.
+func (t AddPetJSONBody) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Note != nil {
+		if utf8.RuneCountInString(*t.Note) > 10 {
+			errs.Add("/note", "length must be at most 10")
+		}
+	}
+	errs.Merge("/pet", t.Pet.Validate())
+	return errs.Err()
+}
+
+// Validate checks that the AddPetJSONRequestBody satisfies the constraints of its schema.
+func (t AddPetJSONRequestBody) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Merge("", AddPetJSONBody(t).Validate())
+	return errs.Err()
+}
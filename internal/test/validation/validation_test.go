@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+func validationErrors(t *testing.T, err error) map[string]string {
+	t.Helper()
+	var errs runtime.ValidationErrors
+	require.True(t, errors.As(err, &errs), "expected validation errors, got %v", err)
+	result := make(map[string]string, len(errs))
+	for _, e := range errs {
+		result[e.Path] = e.Message
+	}
+	return result
+}
+
+func TestValidPet(t *testing.T) {
+	const buf = `{
+		"name": "Tom",
+		"kind": "cat",
+		"age": 3,
+		"weight": 4.5,
+		"tags": ["fluffy", "grumpy"],
+		"owner": {"email": "jon@example.com"},
+		"attributes": {"color": "grey"},
+		"counts": {"lives": 5},
+		"shape": {"kind": "circle", "radius": 1},
+		"number": 42
+	}`
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(buf), &pet))
+	assert.NoError(t, pet.Validate())
+}
+
+func TestInvalidPet(t *testing.T) {
+	const buf = `{
+		"name": "",
+		"kind": "bird",
+		"age": 50,
+		"weight": 4.2,
+		"tags": ["a", "fluffy", "fluffy", "grumpy"],
+		"owner": {"email": "nobody", "handle": "admin"},
+		"attributes": {"a/b": "x"},
+		"counts": {"lives": 9},
+		"shape": {"kind": "square", "side": 0},
+		"number": "abc"
+	}`
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(buf), &pet))
+
+	// The pattern of the handle, which Go can't compile, isn't checked.
+	errs := validationErrors(t, pet.Validate())
+	assert.Equal(t, map[string]string{
+		"/name":            "length must be at least 1",
+		"/kind":            "must be one of [cat dog]",
+		"/age":             "must be less than 50",
+		"/weight":          "must be a multiple of 0.5",
+		"/tags":            "must have unique items",
+		"/tags/0":          "length must be at least 2",
+		"/owner/email":     `must match pattern ^[^@]+@[^@]+$`,
+		"/attributes/a~1b": "length must be at least 2",
+		"/counts/lives":    "must be less than or equal to 5",
+		"/shape/side":      "must be greater than or equal to 1",
+		"/number":          "must match a schema of anyOf",
+	}, errs)
+}
+
+func TestRequiredArray(t *testing.T) {
+	pet := Pet{Name: "Tom", Kind: Cat}
+	errs := validationErrors(t, pet.Validate())
+	assert.Equal(t, map[string]string{"/tags": "is required"}, errs)
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	body := AddPetJSONRequestBody{
+		Pet:  Pet{Name: "Tom", Kind: Dog, Tags: []Tag{"ok"}},
+		Note: new(string),
+	}
+	assert.NoError(t, body.Validate())
+
+	*body.Note = "much too long"
+	body.Pet.Tags = append(body.Pet.Tags, "x")
+	errs := validationErrors(t, body.Validate())
+	assert.Equal(t, map[string]string{
+		"/note":       "length must be at most 10",
+		"/pet/tags/1": "length must be at least 2",
+	}, errs)
+}
+
+func TestValidateParams(t *testing.T) {
+	limit := int32(0)
+	name := "Tom"
+	errs := validationErrors(t, FindPetsParams{Limit: &limit, Name: &name}.Validate())
+	assert.Equal(t, map[string]string{
+		"/limit": "must be greater than or equal to 1",
+		"/name":  "must match pattern ^[a-z]+$",
+	}, errs)
+}
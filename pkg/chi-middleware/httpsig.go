@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+// HTTPSignatureVerifier creates middleware verifying the HTTP Message
+// Signatures of requests, such as those of
+// securityprovider.SecurityProviderHTTPSignature. Requests whose signature is
+// missing or invalid get an HTTP/401, through errorHandler when it's set.
+// Handlers get the id of the key of the signature with
+// httpsig.KeyIDFromContext.
+func HTTPSignatureVerifier(verifier *httpsig.Verifier, errorHandler ErrorHandler) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keyID, err := verifier.Verify(r)
+			if err != nil {
+				if errorHandler != nil {
+					errorHandler(w, err.Error(), http.StatusUnauthorized)
+				} else {
+					http.Error(w, err.Error(), http.StatusUnauthorized)
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(httpsig.ContextWithKeyID(r.Context(), keyID)))
+		})
+	}
+}
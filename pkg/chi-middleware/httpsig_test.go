@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+func TestHTTPSignatureVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := httpsig.NewSigner(key, "client-1")
+	require.NoError(t, err)
+	verifier := &httpsig.Verifier{Keys: httpsig.StaticKeys(map[string]crypto.PublicKey{"client-1": &key.PublicKey})}
+
+	r := chi.NewRouter()
+	r.Use(HTTPSignatureVerifier(verifier, nil))
+	r.Post("/resource", func(w http.ResponseWriter, r *http.Request) {
+		keyID, _ := httpsig.KeyIDFromContext(r.Context())
+		_, _ = w.Write([]byte(keyID))
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/resource", strings.NewReader(`{"name":"Fido"}`))
+	require.NoError(t, signer.Sign(req))
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "client-1", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resource", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Errors go through the error handler when it's set.
+	r = chi.NewRouter()
+	r.Use(HTTPSignatureVerifier(verifier, func(w http.ResponseWriter, message string, statusCode int) {
+		http.Error(w, "custom: "+message, http.StatusForbidden)
+	}))
+	r.Post("/resource", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called for an unsigned request")
+	})
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resource", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "custom: httpsig: request isn't signed\n", rec.Body.String())
+}
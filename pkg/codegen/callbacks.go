@@ -0,0 +1,272 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// extWebhooks is the extension holding the webhooks of OpenAPI 3.1 specs,
+// which OpenAPI 3.0 doesn't know about.
+const extWebhooks = "x-webhooks"
+
+// CallbackDefinition describes the callback or webhook an operation belongs
+// to. The API invokes these operations on its subscribers, rather than
+// serving them.
+type CallbackDefinition struct {
+	Name              string // The name of the callback or webhook
+	Expression        string // The runtime expression of the URL of a callback, empty for webhooks
+	ParentOperationId string // The operation which defines a callback, empty for webhooks
+}
+
+// IsWebhook returns whether this is a webhook, which subscribers register
+// out of band, rather than a callback of an operation.
+func (c CallbackDefinition) IsWebhook() bool {
+	return c.ParentOperationId == ""
+}
+
+// CallbackDefinitions returns the operations of the callbacks of ops, and of
+// the webhooks of the spec. Their paths are where the generated servers route
+// them to, see callbackPath.
+func (g *Generator) CallbackDefinitions(swagger *openapi3.T, ops []OperationDefinition, initialismOverrides bool) ([]OperationDefinition, error) {
+	toCamelCaseFunc := ToCamelCase
+	if initialismOverrides {
+		toCamelCaseFunc = ToCamelCaseWithInitialism
+	}
+
+	var callbacks []OperationDefinition
+	for _, parent := range ops {
+		for _, name := range SortedCallbacksKeys(parent.Spec.Callbacks) {
+			callbackRef := parent.Spec.Callbacks[name]
+			if callbackRef == nil || callbackRef.Value == nil {
+				continue
+			}
+			callback := *callbackRef.Value
+			expressions := make([]string, 0, len(callback))
+			for expression := range callback {
+				expressions = append(expressions, expression)
+			}
+			sort.Strings(expressions)
+
+			for _, expression := range expressions {
+				definition := &CallbackDefinition{
+					Name:              name,
+					Expression:        expression,
+					ParentOperationId: parent.OperationId,
+				}
+				defs, err := g.describeCallback(swagger, callback[expression], definition, parent.OperationId+toCamelCaseFunc(name), toCamelCaseFunc)
+				if err != nil {
+					return nil, fmt.Errorf("error describing callback %s of %s: %w", name, parent.OperationId, err)
+				}
+				callbacks = append(callbacks, defs...)
+			}
+		}
+	}
+
+	webhooks, err := webhookPathItems(swagger)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range SortedPathsKeys(webhooks) {
+		defs, err := g.describeCallback(swagger, webhooks[name], &CallbackDefinition{Name: name}, toCamelCaseFunc(name), toCamelCaseFunc)
+		if err != nil {
+			return nil, fmt.Errorf("error describing webhook %s: %w", name, err)
+		}
+		callbacks = append(callbacks, defs...)
+	}
+	return callbacks, nil
+}
+
+// describeCallback describes the operations of the path item of a callback or
+// webhook. Operations without an operation ID are named after defaultID, which
+// gets the method appended when there are several operations.
+func (g *Generator) describeCallback(swagger *openapi3.T, pathItem *openapi3.PathItem, callback *CallbackDefinition, defaultID string, toCamelCaseFunc func(string) string) ([]OperationDefinition, error) {
+	if pathItem == nil {
+		return nil, nil
+	}
+	requestPath := callbackPath(callback)
+
+	globalParams, err := g.DescribeParameters(pathItem.Parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error describing global parameters: %w", err)
+	}
+
+	pathOps := pathItem.Operations()
+	var defs []OperationDefinition
+	for _, opName := range SortedOperationsKeys(pathOps) {
+		op := pathOps[opName]
+		if op.OperationID == "" {
+			op.OperationID = defaultID
+			if len(pathOps) > 1 {
+				op.OperationID += toCamelCaseFunc(strings.ToLower(opName))
+			}
+		} else {
+			op.OperationID = toCamelCaseFunc(op.OperationID)
+		}
+
+		opDef, err := g.describeOperation(swagger, requestPath, opName, op, globalParams, toCamelCaseFunc)
+		if err != nil {
+			return nil, err
+		}
+		opDef.Callback = callback
+		defs = append(defs, *opDef)
+	}
+	return defs, nil
+}
+
+// callbackPath returns the path which servers route the operations of a
+// callback or webhook to. It's the static path following the runtime
+// expressions of the URL of callbacks, like "/events" for
+// "{$request.body#/callbackUrl}/events", so that the server can be mounted
+// at the URL given to the API. Otherwise, it's the name of the callback or
+// webhook.
+func callbackPath(callback *CallbackDefinition) string {
+	suffix := callback.Expression[strings.LastIndex(callback.Expression, "}")+1:]
+	if strings.HasPrefix(callback.Expression, "{") && strings.HasPrefix(suffix, "/") && !strings.ContainsAny(suffix, "?#") {
+		return suffix
+	}
+	return "/" + callback.Name
+}
+
+// webhookPathItems returns the webhooks of the spec, which the OpenAPI 3.1
+// loader keeps in the x-webhooks extension. kin-openapi leaves extensions
+// alone, so the path items are decoded, and their references to the
+// components of the spec are resolved, the first time they're used.
+func webhookPathItems(swagger *openapi3.T) (openapi3.Paths, error) {
+	value, found := swagger.Extensions[extWebhooks]
+	if !found {
+		return nil, nil
+	}
+	if webhooks, ok := value.(openapi3.Paths); ok {
+		return webhooks, nil
+	}
+
+	var raw []byte
+	switch v := value.(type) {
+	case json.RawMessage:
+		raw = v
+	default:
+		var err error
+		if raw, err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("error encoding webhooks: %w", err)
+		}
+	}
+	var webhooks openapi3.Paths
+	if err := json.Unmarshal(raw, &webhooks); err != nil {
+		return nil, fmt.Errorf("error decoding webhooks: %w", err)
+	}
+
+	for name, pathItem := range webhooks {
+		var resolveErr error
+		resolve := func(ref RefWrapper) (bool, error) {
+			if ref.Ref == "" || ref.HasValue {
+				return true, nil
+			}
+			if err := resolveComponentRef(swagger, ref); err != nil && resolveErr == nil {
+				resolveErr = err
+			}
+			// The components of the spec are resolved already.
+			return false, nil
+		}
+		for _, param := range pathItem.Parameters {
+			_ = walkParameterRef(param, resolve)
+		}
+		for _, op := range pathItem.Operations() {
+			_ = walkOperation(op, resolve)
+		}
+		if resolveErr != nil {
+			return nil, fmt.Errorf("error resolving webhook %s: %w", name, resolveErr)
+		}
+	}
+
+	swagger.Extensions[extWebhooks] = webhooks
+	return webhooks, nil
+}
+
+// resolveComponentRef sets the value of a reference to a component of the
+// spec, such as "#/components/schemas/Pet".
+func resolveComponentRef(swagger *openapi3.T, ref RefWrapper) error {
+	parts := strings.Split(ref.Ref, "/")
+	if len(parts) != 4 || parts[0] != "#" || parts[1] != "components" || swagger.Components == nil {
+		return fmt.Errorf("unsupported reference %s, only references to components are supported", ref.Ref)
+	}
+	kind := parts[2]
+	name := strings.ReplaceAll(strings.ReplaceAll(parts[3], "~1", "/"), "~0", "~")
+	components := swagger.Components
+
+	switch r := ref.SourceRef.(type) {
+	case *openapi3.SchemaRef:
+		if target := components.Schemas[name]; kind == "schemas" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.ParameterRef:
+		if target := components.Parameters[name]; kind == "parameters" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.HeaderRef:
+		if target := components.Headers[name]; kind == "headers" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.RequestBodyRef:
+		if target := components.RequestBodies[name]; kind == "requestBodies" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.ResponseRef:
+		if target := components.Responses[name]; kind == "responses" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.ExampleRef:
+		if target := components.Examples[name]; kind == "examples" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.LinkRef:
+		if target := components.Links[name]; kind == "links" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.CallbackRef:
+		if target := components.Callbacks[name]; kind == "callbacks" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	case *openapi3.SecuritySchemeRef:
+		if target := components.SecuritySchemes[name]; kind == "securitySchemes" && target != nil {
+			r.Value = target.Value
+			return nil
+		}
+	}
+	return fmt.Errorf("reference %s not found", ref.Ref)
+}
+
+// withCallbackServerPrefix sets up the server templates to generate the
+// server of the callbacks and webhooks, which goes along with the server of
+// the API. Its top level identifiers get a Callback prefix, and it shares the
+// declarations which don't depend on the operations, such as the parameter
+// errors, with the server of the API.
+func withCallbackServerPrefix(t *template.Template, opts Configuration, prefix string) *template.Template {
+	shared := opts.Generate.ServerCount() > 1
+	if !shared {
+		prefix = ""
+	}
+	return t.Funcs(template.FuncMap{
+		"serverPrefix":          func() string { return prefix + "Callback" },
+		"callbackPrefix":        func() string { return "Callback" },
+		"sharedServerInterface": func() bool { return shared },
+	})
+}
+
+// GenerateCallbackClient generates the client which invokes the callbacks and
+// webhooks of the API on the URLs of their subscribers.
+func GenerateCallbackClient(t *template.Template, callbacks []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"callback-client.tmpl"}, t, callbacks)
+}
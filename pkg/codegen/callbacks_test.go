@@ -0,0 +1,106 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callbacksSpec = `
+openapi: 3.0.3
+info: {title: callbacks, version: 1.0.0}
+paths:
+  /subscribe:
+    post:
+      operationId: subscribe
+      responses:
+        '200': {description: ok}
+      callbacks:
+        onData:
+          '{$request.query.url}':
+            post:
+              requestBody:
+                content:
+                  application/json:
+                    schema: {$ref: '#/components/schemas/Data'}
+              responses:
+                '200': {description: ok}
+            delete:
+              responses:
+                '200': {description: ok}
+        onStatus:
+          '{$request.body#/url}/status':
+            put:
+              operationId: statusChanged
+              responses:
+                '200': {description: ok}
+components:
+  schemas:
+    Data: {type: string}
+    Webhook: {type: integer}
+x-webhooks:
+  newData:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/Webhook'}
+      responses:
+        '200': {description: ok}
+`
+
+func TestCallbackDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(callbacksSpec))
+	require.NoError(t, err)
+	g := NewGenerator(Configuration{})
+	g.spec = swagger
+
+	// The schemas used by webhooks aren't pruned.
+	pruneUnusedComponents(swagger)
+	assert.Contains(t, swagger.Components.Schemas, "Webhook")
+
+	ops, err := g.OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+	callbacks, err := g.CallbackDefinitions(swagger, ops, false)
+	require.NoError(t, err)
+
+	type callback struct {
+		OperationId, Method, Path, Name, Expression, Parent string
+	}
+	var got []callback
+	for _, op := range callbacks {
+		got = append(got, callback{op.OperationId, op.Method, op.Path, op.Callback.Name, op.Callback.Expression, op.Callback.ParentOperationId})
+	}
+	assert.Equal(t, []callback{
+		{"SubscribeOnDataDelete", "DELETE", "/onData", "onData", "{$request.query.url}", "Subscribe"},
+		{"SubscribeOnDataPost", "POST", "/onData", "onData", "{$request.query.url}", "Subscribe"},
+		{"StatusChanged", "PUT", "/status", "onStatus", "{$request.body#/url}/status", "Subscribe"},
+		{"NewData", "POST", "/newData", "newData", "", ""},
+	}, got)
+
+	assert.True(t, callbacks[3].Callback.IsWebhook())
+	require.Len(t, callbacks[3].Bodies, 1)
+	assert.Equal(t, "Webhook", callbacks[3].Bodies[0].Schema.RefType)
+}
+
+func TestCallbackServersMultipleServers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(callbacksSpec))
+	require.NoError(t, err)
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{ChiServer: true, EchoServer: true, Models: true, Callbacks: true},
+	}
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// The callback servers share one CallbackServerInterface too.
+	assert.Equal(t, 1, strings.Count(code, "type CallbackServerInterface interface {"))
+	assert.Equal(t, 1, strings.Count(code, "type CallbackUnimplemented struct{}"))
+	assert.Contains(t, code, "func ChiCallbackHandler(si CallbackServerInterface) http.Handler {")
+	assert.Contains(t, code, "func RegisterEchoCallbackHandlers(router EchoRouter, si CallbackServerInterface) {")
+	checkLint(t, "test.gen.go", []byte(code))
+}
@@ -15,7 +15,6 @@
 package codegen
 
 import (
-	"bufio"
 	"bytes"
 	"embed"
 	"fmt"
@@ -37,12 +36,45 @@ import (
 //go:embed templates
 var templates embed.FS
 
-// globalState stores all global state. Please don't put global state anywhere
-// else so that we can easily track it.
-var globalState struct {
-	options       Configuration
-	spec          *openapi3.T
-	importMapping importMap
+// Generator generates Go code from an OpenAPI spec. It holds all the state of
+// a generation, so that generations with their own Generators can run
+// concurrently. A Generator generates one spec at a time.
+type Generator struct {
+	options        Configuration
+	spec           *openapi3.T
+	importMapping  importMap
+	schemaVariants []schemaVariant
+	hooks          []Hooks
+	warnings       []string
+}
+
+// NewGenerator creates a Generator which generates code with opts.
+func NewGenerator(opts Configuration) *Generator {
+	if opts.OutputOptions.ClientTypeName == "" {
+		opts.OutputOptions.ClientTypeName = defaultClientTypeName
+	}
+	return &Generator{
+		options:       opts,
+		importMapping: constructImportMapping(opts.ImportMapping),
+	}
+}
+
+// Warnings returns the warnings of the last generation of g, about the parts
+// of the spec it couldn't generate all the code for, such as the patterns
+// which Go can't compile.
+func (g *Generator) Warnings() []string {
+	return g.warnings
+}
+
+// warnf records a warning of the generation, unless it was already recorded.
+func (g *Generator) warnf(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	for _, w := range g.warnings {
+		if w == warning {
+			return
+		}
+	}
+	g.warnings = append(g.warnings, warning)
 }
 
 // goImport represents a go package to be imported in the generated code
@@ -96,37 +128,166 @@ func constructImportMapping(importMapping map[string]string) importMap {
 	return result
 }
 
+// Names of the files produced by GenerateFiles, one for each generated
+// concern.
+const (
+	TypesFileName               = "types.gen.go"
+	ClientFileName              = "client.gen.go"
+	ClientWithResponsesFileName = "client-with-responses.gen.go"
+	ServerInterfaceFileName     = "server-interface.gen.go"
+	EchoServerFileName          = "echo-server.gen.go"
+	ChiServerFileName           = "chi-server.gen.go"
+	FiberServerFileName         = "fiber-server.gen.go"
+	GinServerFileName           = "gin-server.gen.go"
+	GorillaServerFileName       = "gorilla-server.gen.go"
+	StdHTTPServerFileName       = "std-http-server.gen.go"
+	StrictServerFileName        = "strict-server.gen.go"
+	MockServerFileName          = "mock-server.gen.go"
+	SpecFileName                = "spec.gen.go"
+)
+
+// codeSection is a chunk of generated code, along with the name of the file
+// it is written to when the output is split by concern.
+type codeSection struct {
+	FileName string
+	Code     string
+}
+
 // Generate uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
 // opts defines
 func Generate(spec *openapi3.T, opts Configuration) (string, error) {
-	// This is global state
-	globalState.options = opts
-	globalState.spec = spec
-	globalState.importMapping = constructImportMapping(opts.ImportMapping)
+	return NewGenerator(opts).Generate(spec)
+}
+
+// Generate generates the code for spec, like the Generate function does with
+// the options of g.
+func (g *Generator) Generate(spec *openapi3.T) (string, error) {
+	t, externalImports, sections, err := g.generateSections(spec)
+	if err != nil {
+		return "", err
+	}
+
+	importsOut, err := g.GenerateImports(t, externalImports, g.options.PackageName, true)
+	if err != nil {
+		return "", fmt.Errorf("error generating imports: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(importsOut)
+	for _, section := range sections {
+		buf.WriteString(section.Code)
+	}
+
+	return formatCode(g.options.PackageName+".go", buf.String(), g.options)
+}
+
+// GenerateFiles behaves like Generate, but rather than producing a single Go
+// source file, it splits the generated code by concern: models, client,
+// client with responses, each server flavour, the strict server and the
+// embedded spec each go into their own file. The result maps file names (see
+// TypesFileName and friends) to file contents. Every file carries its own
+// package clause and imports, and is only present when the corresponding
+// code was requested in opts.Generate.
+func GenerateFiles(spec *openapi3.T, opts Configuration) (map[string]string, error) {
+	return NewGenerator(opts).GenerateFiles(spec)
+}
+
+// GenerateFiles generates the code for spec split by concern, like the
+// GenerateFiles function does with the options of g.
+func (g *Generator) GenerateFiles(spec *openapi3.T) (map[string]string, error) {
+	opts := g.options
+	t, externalImports, sections, err := g.generateSections(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileNames []string
+	contents := make(map[string]*bytes.Buffer)
+	for _, section := range sections {
+		buf, found := contents[section.FileName]
+		if !found {
+			// Only the first file documents the package, so that godoc and
+			// linters don't see several competing package comments.
+			importsOut, err := g.GenerateImports(t, externalImports, opts.PackageName, len(fileNames) == 0)
+			if err != nil {
+				return nil, fmt.Errorf("error generating imports for %s: %w", section.FileName, err)
+			}
+			buf = bytes.NewBufferString(importsOut)
+			contents[section.FileName] = buf
+			fileNames = append(fileNames, section.FileName)
+		}
+		buf.WriteString(section.Code)
+	}
+
+	files := make(map[string]string, len(fileNames))
+	for _, fileName := range fileNames {
+		code, err := formatCode(fileName, contents[fileName].String(), opts)
+		if err != nil {
+			return nil, err
+		}
+		files[fileName] = code
+	}
+	return files, nil
+}
+
+// formatCode sanitizes the generated code, and unless told otherwise, runs it
+// through goimports, which also drops any imports the code doesn't use.
+func formatCode(fileName string, goCode string, opts Configuration) (string, error) {
+	// remove any byte-order-marks which break Go-Code
+	goCode = SanitizeCode(goCode)
+
+	// The generation code produces unindented horrors. Use the Go Imports
+	// to make it all pretty.
+	if opts.OutputOptions.SkipFmt {
+		return goCode, nil
+	}
+
+	outBytes, err := imports.Process(fileName, []byte(goCode), nil)
+	if err != nil {
+		return "", fmt.Errorf("error formatting Go code %s: %w", goCode, err)
+	}
+	return string(outBytes), nil
+}
+
+// generateSections runs every code generator requested by the options of g,
+// and returns the template set used, the external imports the code may need,
+// and the generated code in output order.
+func (g *Generator) generateSections(spec *openapi3.T) (*template.Template, []string, []codeSection, error) {
+	opts := g.options
+	g.spec = spec
+	g.warnings = nil
+
+	if err := g.afterSpecLoad(spec); err != nil {
+		return nil, nil, nil, fmt.Errorf("error in hook after loading the spec: %w", err)
+	}
 
 	filterOperationsByTag(spec, opts)
 	if !opts.OutputOptions.SkipPrune {
 		pruneUnusedComponents(spec)
 	}
 
-	// if we are provided an override for the response type suffix update it
-	if opts.OutputOptions.ResponseTypeSuffix != "" {
-		responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
-	}
-
-	if globalState.options.OutputOptions.ClientTypeName == "" {
-		globalState.options.OutputOptions.ClientTypeName = defaultClientTypeName
+	// The code is generated from a copy of the spec which refers to the
+	// request and response variants of schemas, but the spec is embedded
+	// as it is.
+	embeddedSpec := spec
+	g.schemaVariants = nil
+	if opts.OutputOptions.ReadWriteVariants {
+		var err error
+		spec, g.schemaVariants, err = withReadWriteVariants(spec, opts.OutputOptions.ExcludeSchemas)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error making request and response variants of schemas: %w", err)
+		}
+		g.spec = spec
 	}
 
 	// This creates the golang templates text package
-	TemplateFunctions["opts"] = func() Configuration { return globalState.options }
-	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	t := template.New("oapi-codegen").Funcs(g.templateFunctions())
 	// This parses all of our own template files into the template object
 	// above
 	err := LoadTemplates(templates, t)
 	if err != nil {
-		return "", fmt.Errorf("error parsing oapi-codegen templates: %w", err)
+		return nil, nil, nil, fmt.Errorf("error parsing oapi-codegen templates: %w", err)
 	}
 
 	// load user-provided templates. Will Override built-in versions.
@@ -135,259 +296,240 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 
 		txt, err := GetUserTemplateText(template)
 		if err != nil {
-			return "", fmt.Errorf("error loading user-provided template %q: %w", name, err)
+			return nil, nil, nil, fmt.Errorf("error loading user-provided template %q: %w", name, err)
 		}
 
 		_, err = utpl.Parse(txt)
 		if err != nil {
-			return "", fmt.Errorf("error parsing user-provided template %q: %w", name, err)
+			return nil, nil, nil, fmt.Errorf("error parsing user-provided template %q: %w", name, err)
 		}
 	}
 
-	ops, err := OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
+	ops, err := g.OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
 	if err != nil {
-		return "", fmt.Errorf("error creating operation definitions: %w", err)
+		return nil, nil, nil, fmt.Errorf("error creating operation definitions: %w", err)
 	}
-
-	xGoTypeImports, err := OperationImports(ops)
+	ops, err = g.afterOperationDefinitions(ops)
 	if err != nil {
-		return "", fmt.Errorf("error getting operation imports: %w", err)
+		return nil, nil, nil, fmt.Errorf("error in hook after creating operation definitions: %w", err)
 	}
-
-	var typeDefinitions, constantDefinitions string
-	if opts.Generate.Models {
-		typeDefinitions, err = GenerateTypeDefinitions(t, spec, ops, opts.OutputOptions.ExcludeSchemas)
-		if err != nil {
-			return "", fmt.Errorf("error generating type definitions: %w", err)
-		}
-
-		constantDefinitions, err = GenerateConstants(t, ops)
-		if err != nil {
-			return "", fmt.Errorf("error generating constants: %w", err)
+	if opts.Generate.Client {
+		if err := checkVariantOperationNames(g.schemaVariants, ops, g.genResponseTypeName); err != nil {
+			return nil, nil, nil, fmt.Errorf("error making request and response variants of schemas: %w", err)
 		}
-
-		imprts, err := GetTypeDefinitionsImports(spec, opts.OutputOptions.ExcludeSchemas)
-		if err != nil {
-			return "", fmt.Errorf("error getting type definition imports: %w", err)
+		if opts.OutputOptions.ClientResponseErrors {
+			if err := checkClientErrorNames(spec, g.schemaVariants, ops); err != nil {
+				return nil, nil, nil, fmt.Errorf("error making the errors of the client: %w", err)
+			}
 		}
-		MergeImports(xGoTypeImports, imprts)
 	}
 
-	var echoServerOut string
-	if opts.Generate.EchoServer {
-		echoServerOut, err = GenerateEchoServer(t, ops)
+	var callbacks []OperationDefinition
+	if opts.Generate.Callbacks {
+		callbacks, err = g.CallbackDefinitions(spec, ops, opts.OutputOptions.InitialismOverrides)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, nil, fmt.Errorf("error creating callback definitions: %w", err)
 		}
 	}
+	// The types of callbacks are generated along with the types of the
+	// operations of the API.
+	typeOps := append(ops[:len(ops):len(ops)], callbacks...)
 
-	var chiServerOut string
-	if opts.Generate.ChiServer {
-		chiServerOut, err = GenerateChiServer(t, ops)
-		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
-		}
+	xGoTypeImports, err := OperationImports(typeOps)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting operation imports: %w", err)
 	}
 
-	var fiberServerOut string
-	if opts.Generate.FiberServer {
-		fiberServerOut, err = GenerateFiberServer(t, ops)
+	var sections []codeSection
+
+	if opts.Generate.Models {
+		constantDefinitions, err := GenerateConstants(t, typeOps)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating constants: %w", err)
 		}
-	}
 
-	var ginServerOut string
-	if opts.Generate.GinServer {
-		ginServerOut, err = GenerateGinServer(t, ops)
+		typeDefinitions, err := g.GenerateTypeDefinitions(t, spec, typeOps, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating type definitions: %w", err)
 		}
-	}
 
-	var gorillaServerOut string
-	if opts.Generate.GorillaServer {
-		gorillaServerOut, err = GenerateGorillaServer(t, ops)
+		imprts, err := GetTypeDefinitionsImports(spec, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, nil, fmt.Errorf("error getting type definition imports: %w", err)
 		}
+		MergeImports(xGoTypeImports, imprts)
+
+		sections = append(sections,
+			codeSection{FileName: TypesFileName, Code: constantDefinitions},
+			codeSection{FileName: TypesFileName, Code: typeDefinitions},
+		)
 	}
 
-	var strictServerOut string
-	if opts.Generate.Strict {
-		var responses []ResponseDefinition
-		if spec.Components != nil {
-			responses, err = GenerateResponseDefinitions("", spec.Components.Responses)
-			if err != nil {
-				return "", fmt.Errorf("error generation response definitions for schema: %w", err)
-			}
-		}
-		strictServerResponses, err := GenerateStrictResponses(t, responses)
+	if opts.Generate.Client {
+		servers, err := ServerDefinitions(spec)
 		if err != nil {
-			return "", fmt.Errorf("error generation response definitions for schema: %w", err)
+			return nil, nil, nil, fmt.Errorf("error creating server definitions: %w", err)
 		}
-		strictServerOut, err = GenerateStrictServer(t, ops, opts)
+		serverURLsOut, err := GenerateServerURLs(t, servers, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating server URLs: %w", err)
 		}
-		strictServerOut = strictServerResponses + strictServerOut
-	}
 
-	var clientOut string
-	if opts.Generate.Client {
-		clientOut, err = GenerateClient(t, ops)
+		clientOut, err := GenerateClient(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating client: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating client: %w", err)
 		}
-	}
+		clientOut = serverURLsOut + clientOut
 
-	var clientWithResponsesOut string
-	if opts.Generate.Client {
-		clientWithResponsesOut, err = GenerateClientWithResponses(t, ops)
+		clientWithResponsesOut, err := GenerateClientWithResponses(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating client with responses: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating client with responses: %w", err)
 		}
-	}
 
-	var inlinedSpec string
-	if opts.Generate.EmbeddedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, globalState.importMapping, spec)
-		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		if len(callbacks) != 0 {
+			callbackClientOut, err := GenerateCallbackClient(t, callbacks)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("error generating callback client: %w", err)
+			}
+			clientOut += callbackClientOut
 		}
-	}
 
-	var buf bytes.Buffer
-	w := bufio.NewWriter(&buf)
-
-	externalImports := append(globalState.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
-	importsOut, err := GenerateImports(t, externalImports, opts.PackageName)
-	if err != nil {
-		return "", fmt.Errorf("error generating imports: %w", err)
-	}
-
-	_, err = w.WriteString(importsOut)
-	if err != nil {
-		return "", fmt.Errorf("error writing imports: %w", err)
-	}
-
-	_, err = w.WriteString(constantDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing constants: %w", err)
-	}
-
-	_, err = w.WriteString(typeDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing type definitions: %w", err)
+		sections = append(sections,
+			codeSection{FileName: ClientFileName, Code: clientOut},
+			codeSection{FileName: ClientWithResponsesFileName, Code: clientWithResponsesOut},
+		)
 	}
 
-	if opts.Generate.Client {
-		_, err = w.WriteString(clientOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
-		}
-		_, err = w.WriteString(clientWithResponsesOut)
+	if opts.Generate.ServerCount() > 1 {
+		serverInterfaceOut, err := generateSharedServerInterface(t, ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating server interface: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: ServerInterfaceFileName, Code: serverInterfaceOut})
 	}
 
 	if opts.Generate.EchoServer {
-		_, err = w.WriteString(echoServerOut)
+		echoServerOut, err := generateServer(GenerateEchoServer, t, opts, "Echo", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: EchoServerFileName, Code: echoServerOut})
 	}
 
 	if opts.Generate.ChiServer {
-		_, err = w.WriteString(chiServerOut)
+		chiServerOut, err := generateServer(GenerateChiServer, t, opts, "Chi", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: ChiServerFileName, Code: chiServerOut})
 	}
 
 	if opts.Generate.FiberServer {
-		_, err = w.WriteString(fiberServerOut)
+		fiberServerOut, err := generateServer(GenerateFiberServer, t, opts, "Fiber", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: FiberServerFileName, Code: fiberServerOut})
 	}
 
 	if opts.Generate.GinServer {
-		_, err = w.WriteString(ginServerOut)
+		ginServerOut, err := generateServer(GenerateGinServer, t, opts, "Gin", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: GinServerFileName, Code: ginServerOut})
 	}
 
 	if opts.Generate.GorillaServer {
-		_, err = w.WriteString(gorillaServerOut)
+		gorillaServerOut, err := generateServer(GenerateGorillaServer, t, opts, "Gorilla", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: GorillaServerFileName, Code: gorillaServerOut})
 	}
 
-	if opts.Generate.Strict {
-		_, err = w.WriteString(strictServerOut)
+	if opts.Generate.StdHTTPServer {
+		stdHTTPServerOut, err := generateServer(GenerateStdHTTPServer, t, opts, "StdHTTP", ops, callbacks)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
+		sections = append(sections, codeSection{FileName: StdHTTPServerFileName, Code: stdHTTPServerOut})
 	}
 
-	if opts.Generate.EmbeddedSpec {
-		_, err = w.WriteString(inlinedSpec)
+	if opts.Generate.Strict {
+		var responses []ResponseDefinition
+		if spec.Components != nil {
+			responses, err = g.GenerateResponseDefinitions("", spec.Components.Responses)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("error generation response definitions for schema: %w", err)
+			}
+		}
+		strictServerResponses, err := GenerateStrictResponses(t, responses)
 		if err != nil {
-			return "", fmt.Errorf("error writing inlined spec: %w", err)
+			return nil, nil, nil, fmt.Errorf("error generation response definitions for schema: %w", err)
 		}
+		strictServerOut, err := GenerateStrictServer(t, ops, opts)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+		sections = append(sections, codeSection{FileName: StrictServerFileName, Code: strictServerResponses + strictServerOut})
 	}
 
-	err = w.Flush()
-	if err != nil {
-		return "", fmt.Errorf("error flushing output buffer: %w", err)
+	if opts.Generate.MockServer {
+		mockServerOut, err := GenerateMockServer(t, ops, opts)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error generating mock server: %w", err)
+		}
+		sections = append(sections, codeSection{FileName: MockServerFileName, Code: mockServerOut})
 	}
 
-	// remove any byte-order-marks which break Go-Code
-	goCode := SanitizeCode(buf.String())
-
-	// The generation code produces unindented horrors. Use the Go Imports
-	// to make it all pretty.
-	if opts.OutputOptions.SkipFmt {
-		return goCode, nil
+	if opts.Generate.EmbeddedSpec {
+		inlinedSpec, err := GenerateInlinedSpec(t, g.importMapping, embeddedSpec)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+		sections = append(sections, codeSection{FileName: SpecFileName, Code: inlinedSpec})
 	}
 
-	outBytes, err := imports.Process(opts.PackageName+".go", []byte(goCode), nil)
-	if err != nil {
-		return "", fmt.Errorf("error formatting Go code %s: %w", goCode, err)
-	}
-	return string(outBytes), nil
+	externalImports := append(g.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
+	return t, externalImports, sections, nil
 }
 
-func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+func (g *Generator) GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
 	var allTypes []TypeDefinition
 	if swagger.Components != nil {
-		schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
+		schemaTypes, err := g.GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component schemas: %w", err)
 		}
 
-		paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+		paramTypes, err := g.GenerateTypesForParameters(t, swagger.Components.Parameters)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component parameters: %w", err)
 		}
-		allTypes = append(schemaTypes, paramTypes...)
+		variantTypes, err := g.generateTypesForSchemaVariants(g.schemaVariants)
+		if err != nil {
+			return "", fmt.Errorf("error generating Go types for schema variants: %w", err)
+		}
+		allTypes = append(schemaTypes, variantTypes...)
+		allTypes = append(allTypes, paramTypes...)
 
-		responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+		responseTypes, err := g.GenerateTypesForResponses(t, swagger.Components.Responses)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component responses: %w", err)
 		}
 		allTypes = append(allTypes, responseTypes...)
 
-		bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+		bodyTypes, err := g.GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
 		}
 		allTypes = append(allTypes, bodyTypes...)
 	}
+	allTypes, err := g.afterTypeDefinitions(allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error in hook after creating type definitions: %w", err)
+	}
 
 	// Go through all operations, and add their types to allTypes, so that we can
 	// scan all of them for enums. Operation definitions are handled differently
@@ -403,7 +545,7 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
 	}
 
-	enumsOut, err := GenerateEnums(t, enumTypes)
+	enumsOut, err := g.GenerateEnums(t, enumTypes)
 	if err != nil {
 		return "", fmt.Errorf("error generating code for type enums: %w", err)
 	}
@@ -428,7 +570,23 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating boilerplate for union types with additionalProperties: %w", err)
 	}
 
-	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate}, "")
+	var defaultsOut string
+	if g.options.Generate.Defaults {
+		defaultsOut, err = g.GenerateDefaults(t, allTypes, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating defaults code: %w", err)
+		}
+	}
+
+	var validationOut string
+	if g.options.Generate.Validation {
+		validationOut, err = g.GenerateValidation(t, allTypes, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating validation code: %w", err)
+		}
+	}
+
+	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate, defaultsOut, validationOut}, "")
 	return typeDefinitions, nil
 }
 
@@ -460,7 +618,7 @@ func GenerateConstants(t *template.Template, ops []OperationDefinition) (string,
 
 // GenerateTypesForSchemas generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
-func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
 	excludeSchemasMap := make(map[string]bool)
 	for _, schema := range excludeSchemas {
 		excludeSchemasMap[schema] = true
@@ -473,7 +631,7 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 		}
 		schemaRef := schemas[schemaName]
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		goSchema, err := g.GenerateGoSchema(schemaRef, []string{schemaName})
 		if err != nil {
 			return nil, fmt.Errorf("error converting Schema %s to Go type: %w", schemaName, err)
 		}
@@ -496,12 +654,12 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 
 // GenerateTypesForParameters generates type definitions for any custom types defined in the
 // components/parameters section of the Swagger spec.
-func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 	for _, paramName := range SortedParameterKeys(params) {
 		paramOrRef := params[paramName]
 
-		goType, err := paramToGoType(paramOrRef.Value, nil)
+		goType, err := g.paramToGoType(paramOrRef.Value, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error generating Go type for schema in parameter %s: %w", paramName, err)
 		}
@@ -519,7 +677,7 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 
 		if paramOrRef.Ref != "" {
 			// Generate a reference type for referenced parameters
-			refType, err := RefPathToGoType(paramOrRef.Ref)
+			refType, err := g.RefPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", paramOrRef.Ref, paramName, err)
 			}
@@ -533,7 +691,7 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 
 // GenerateTypesForResponses generates type definitions for any custom types defined in the
 // components/responses section of the Swagger spec.
-func GenerateTypesForResponses(t *template.Template, responses openapi3.Responses) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForResponses(t *template.Template, responses openapi3.Responses) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, responseName := range SortedResponsesKeys(responses) {
@@ -545,7 +703,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 		response := responseOrRef.Value
 		jsonResponse, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonResponse.Schema, []string{responseName})
+			goType, err := g.GenerateGoSchema(jsonResponse.Schema, []string{responseName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in response %s: %w", responseName, err)
 			}
@@ -563,7 +721,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 
 			if responseOrRef.Ref != "" {
 				// Generate a reference type for referenced parameters
-				refType, err := RefPathToGoType(responseOrRef.Ref)
+				refType, err := g.RefPathToGoType(responseOrRef.Ref)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", responseOrRef.Ref, responseName, err)
 				}
@@ -577,7 +735,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 
 // GenerateTypesForRequestBodies generates type definitions for any custom types defined in the
 // components/requestBodies section of the Swagger spec.
-func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, requestBodyName := range SortedRequestBodyKeys(bodies) {
@@ -588,7 +746,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 		response := requestBodyRef.Value
 		jsonBody, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonBody.Schema, []string{requestBodyName})
+			goType, err := g.GenerateGoSchema(jsonBody.Schema, []string{requestBodyName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in body %s: %w", requestBodyName, err)
 			}
@@ -606,7 +764,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 
 			if requestBodyRef.Ref != "" {
 				// Generate a reference type for referenced bodies
-				refType, err := RefPathToGoType(requestBodyRef.Ref)
+				refType, err := g.RefPathToGoType(requestBodyRef.Ref)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in body %s: %w", requestBodyRef.Ref, requestBodyName, err)
 				}
@@ -618,6 +776,59 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 	return types, nil
 }
 
+// checkClientErrorNames checks that the names of the error types which
+// ClientWithResponses declares for operations, such as CreateUserError for
+// createUser, aren't taken by the types of the components, of their variants,
+// or of operations.
+func checkClientErrorNames(swagger *openapi3.T, variants []schemaVariant, ops []OperationDefinition) error {
+	taken := map[string]string{}
+	if components := swagger.Components; components != nil {
+		for name, schema := range components.Schemas {
+			goName, err := renameSchema(name, schema)
+			if err != nil {
+				return err
+			}
+			taken[goName] = "components/schemas/" + name
+		}
+		for name, param := range components.Parameters {
+			goName, err := renameParameter(name, param)
+			if err != nil {
+				return err
+			}
+			taken[goName] = "components/parameters/" + name
+		}
+		for name, response := range components.Responses {
+			goName, err := renameResponse(name, response)
+			if err != nil {
+				return err
+			}
+			taken[goName] = "components/responses/" + name
+		}
+		for name, body := range components.RequestBodies {
+			goName, err := renameRequestBody(name, body)
+			if err != nil {
+				return err
+			}
+			taken[goName] = "components/requestBodies/" + name
+		}
+	}
+	for _, variant := range variants {
+		taken[variant.TypeName] = "a variant of components/schemas/" + variant.SchemaName
+	}
+	for _, op := range ops {
+		for _, td := range op.TypeDefinitions {
+			taken[td.TypeName] = "a type of operation " + op.OperationId
+		}
+	}
+	for _, op := range ops {
+		errorName := UppercaseFirstCharacter(op.OperationId) + "Error"
+		if owner, found := taken[errorName]; found {
+			return fmt.Errorf("the name %s of the error type of operation %s is taken by %s, please use x-go-name to rename the type, or rename the operation", errorName, op.OperationId, owner)
+		}
+	}
+	return nil
+}
+
 // GenerateTypes passes a bunch of types to the template engine, and buffers
 // its output into a string.
 func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error) {
@@ -651,7 +862,7 @@ func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error)
 	return GenerateTemplates([]string{"typedef.tmpl"}, t, context)
 }
 
-func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+func (g *Generator) GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
 	enums := []EnumDefinition{}
 
 	// Keep track of which enums we've generated
@@ -674,7 +885,7 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 				Schema:         tp.Schema,
 				TypeName:       tp.TypeName,
 				ValueWrapper:   wrapper,
-				PrefixTypeName: globalState.options.Compatibility.AlwaysPrefixEnumValues,
+				PrefixTypeName: g.options.Compatibility.AlwaysPrefixEnumValues,
 			})
 		}
 	}
@@ -727,8 +938,66 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 	return GenerateTemplates([]string{"constants.tmpl"}, t, Constants{EnumDefinitions: enums})
 }
 
-// GenerateImports generates our import statements and package definition.
-func GenerateImports(t *template.Template, externalImports []string, packageName string) (string, error) {
+// generateServer generates a server with gen, followed by the server of the
+// callbacks and webhooks, if there are any.
+func generateServer(gen func(*template.Template, []OperationDefinition) (string, error), t *template.Template, opts Configuration, prefix string, ops []OperationDefinition, callbacks []OperationDefinition) (string, error) {
+	out, err := gen(withServerPrefix(t, opts, prefix), ops)
+	if err != nil || len(callbacks) == 0 {
+		return out, err
+	}
+	callbacksOut, err := gen(withCallbackServerPrefix(t, opts, prefix), callbacks)
+	if err != nil {
+		return "", fmt.Errorf("error generating callback server: %w", err)
+	}
+	return out + "\n" + callbacksOut, nil
+}
+
+// withServerPrefix sets the prefix which the server templates put in front of
+// their top level identifiers, so that several server types can live in the
+// same package. When only one server type is generated the prefix is left
+// empty, which keeps the names that single server code has always had.
+//
+// Several server types share one ServerInterface instead, which
+// generateSharedServerInterface declares, so that a single implementation of
+// the API is served by all of them: only their wrappers and registration
+// functions get the prefix.
+func withServerPrefix(t *template.Template, opts Configuration, prefix string) *template.Template {
+	shared := opts.Generate.ServerCount() > 1
+	if !shared {
+		prefix = ""
+	}
+	return t.Funcs(template.FuncMap{
+		"serverPrefix":          func() string { return prefix },
+		"callbackPrefix":        func() string { return "" },
+		"sharedServerInterface": func() bool { return shared },
+	})
+}
+
+// generateSharedServerInterface generates the ServerInterface and
+// Unimplemented handlers shared by the servers when several server types are
+// generated, followed by those of the callbacks and webhooks, if there are
+// any. Their handlers have the signature of net/http handlers, which every
+// router can call.
+func generateSharedServerInterface(t *template.Template, ops []OperationDefinition, callbacks []OperationDefinition) (string, error) {
+	out, err := GenerateTemplates([]string{"chi/chi-interface.tmpl"}, t, ops)
+	if err != nil || len(callbacks) == 0 {
+		return out, err
+	}
+	callbacksOut, err := GenerateTemplates([]string{"chi/chi-interface.tmpl"}, t.Funcs(template.FuncMap{
+		"serverPrefix":   func() string { return "Callback" },
+		"callbackPrefix": func() string { return "Callback" },
+	}), callbacks)
+	if err != nil {
+		return "", fmt.Errorf("error generating callback server interface: %w", err)
+	}
+	return out + "\n" + callbacksOut, nil
+}
+
+// GenerateImports generates our import statements and package definition. When
+// withPackageDoc is false, the header only carries the generated code notice,
+// which lets several generated files share a package without each of them
+// documenting it.
+func (g *Generator) GenerateImports(t *template.Template, externalImports []string, packageName string, withPackageDoc bool) (string, error) {
 	// Read build version for incorporating into generated files
 	// Unit tests have ok=false, so we'll just use "unknown" for the
 	// version if we can't read this.
@@ -750,12 +1019,16 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 		ModuleName        string
 		Version           string
 		AdditionalImports []AdditionalImport
+		WithPackageDoc    bool
+		InputHash         string
 	}{
 		ExternalImports:   externalImports,
 		PackageName:       packageName,
 		ModuleName:        modulePath,
 		Version:           moduleVersion,
-		AdditionalImports: globalState.options.AdditionalImports,
+		AdditionalImports: g.options.AdditionalImports,
+		WithPackageDoc:    withPackageDoc,
+		InputHash:         g.options.InputHash,
 	}
 
 	return GenerateTemplates([]string{"imports.tmpl"}, t, context)
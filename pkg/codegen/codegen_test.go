@@ -8,7 +8,10 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"text/template"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/golangci/lint-1"
@@ -78,6 +81,287 @@ func TestExamplePetStoreCodeGeneration(t *testing.T) {
 	checkLint(t, "test.gen.go", []byte(code))
 }
 
+func TestExamplePetStoreCodeGenerationSplitFiles(t *testing.T) {
+
+	// Input vars for code generation:
+	packageName := "api"
+	opts := Configuration{
+		PackageName: packageName,
+		Generate: GenerateOptions{
+			EchoServer:   true,
+			Client:       true,
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+	}
+
+	// Get a spec from the example PetStore definition:
+	swagger, err := examplePetstore.GetSwagger()
+	assert.NoError(t, err)
+
+	// Run our code generation:
+	files, err := GenerateFiles(swagger, opts)
+	require.NoError(t, err)
+
+	// Check that each concern ended up in its own file:
+	assert.Len(t, files, 5)
+	for _, fileName := range []string{TypesFileName, ClientFileName, ClientWithResponsesFileName, EchoServerFileName, SpecFileName} {
+		code, found := files[fileName]
+		require.True(t, found, "missing %s", fileName)
+
+		// Check that we have valid (formattable) code:
+		_, err = format.Source([]byte(code))
+		assert.NoError(t, err)
+
+		// Check that we have a package:
+		assert.Contains(t, code, "package api")
+	}
+
+	// Only the first file documents the package:
+	assert.Contains(t, files[TypesFileName], "// Package api provides primitives to interact with the openapi HTTP API.")
+	assert.NotContains(t, files[ClientFileName], "// Package api")
+	assert.Contains(t, files[ClientFileName], "DO NOT EDIT.\n\npackage api")
+
+	// Check that the code is split by concern:
+	assert.Contains(t, files[TypesFileName], "type Pet struct {")
+	assert.NotContains(t, files[TypesFileName], "type ServerInterface interface {")
+	assert.Contains(t, files[ClientFileName], "func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, files[ClientWithResponsesFileName], "type ClientWithResponses struct {")
+	assert.Contains(t, files[EchoServerFileName], "type ServerInterface interface {")
+	assert.Contains(t, files[SpecFileName], "func GetSwagger() (swagger *openapi3.T, err error) {")
+
+	// Check that each file only imports what it needs:
+	assert.NotContains(t, files[TypesFileName], `"github.com/labstack/echo/v4"`)
+	assert.NotContains(t, files[ClientFileName], `"github.com/labstack/echo/v4"`)
+	assert.Contains(t, files[EchoServerFileName], `"github.com/labstack/echo/v4"`)
+	assert.NotContains(t, files[EchoServerFileName], `"github.com/getkin/kin-openapi/openapi3"`)
+
+	// Make sure the generated code is valid:
+	for fileName, code := range files {
+		checkLint(t, fileName, []byte(code))
+	}
+}
+
+func TestExamplePetStoreCodeGenerationMultipleServers(t *testing.T) {
+
+	// Input vars for code generation:
+	packageName := "api"
+	opts := Configuration{
+		PackageName: packageName,
+		Generate: GenerateOptions{
+			EchoServer:    true,
+			ChiServer:     true,
+			GorillaServer: true,
+			StdHTTPServer: true,
+			Strict:        true,
+			Models:        true,
+		},
+	}
+	require.NoError(t, opts.Validate())
+
+	// Get a spec from the example PetStore definition:
+	swagger, err := examplePetstore.GetSwagger()
+	assert.NoError(t, err)
+
+	// Run our code generation:
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// The servers share one ServerInterface, with the signature of net/http
+	// handlers, and only their wrappers and registration functions are
+	// prefixed:
+	assert.Equal(t, 1, strings.Count(code, "type ServerInterface interface {"))
+	assert.Equal(t, 1, strings.Count(code, "type Unimplemented struct{}"))
+	assert.Contains(t, code, "FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams)")
+	assert.Contains(t, code, "type EchoServerInterfaceWrapper struct {")
+	assert.Contains(t, code, "func RegisterEchoHandlers(router EchoRouter, si ServerInterface) {")
+	assert.Contains(t, code, "w.Handler.FindPets(ctx.Response(), ctx.Request(), params)")
+	assert.Contains(t, code, "func ChiHandler(si ServerInterface) http.Handler {")
+	assert.Contains(t, code, "func GorillaHandler(si ServerInterface) http.Handler {")
+	assert.Contains(t, code, "func StdHTTPHandler(si ServerInterface) http.Handler {")
+	assert.NotContains(t, code, "type EchoServerInterface interface {")
+	assert.NotContains(t, code, "type ChiServerInterface interface {")
+
+	// So does the strict handler, which serves all of them:
+	assert.Equal(t, 1, strings.Count(code, "type StrictServerInterface interface {"))
+	assert.Contains(t, code, "func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {")
+	assert.NotContains(t, code, "func NewEchoStrictHandler(")
+
+	// Chi, Gorilla and net/http share their parameter error types:
+	assert.Equal(t, 1, strings.Count(code, "type RequiredParamError struct {"))
+
+	// Make sure the generated code is valid:
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestGenerateConcurrently(t *testing.T) {
+	configs := []Configuration{
+		{
+			PackageName: "api",
+			Generate:    GenerateOptions{Client: true, Models: true},
+		},
+		{
+			PackageName: "other",
+			Generate:    GenerateOptions{Client: true, Models: true, ChiServer: true},
+			OutputOptions: OutputOptions{
+				ResponseTypeSuffix: "Result",
+				ClientTypeName:     "PetStore",
+			},
+			Compatibility: CompatibilityOptions{OldAliasing: true},
+		},
+	}
+
+	generate := func(opts Configuration) (string, error) {
+		swagger, err := examplePetstore.GetSwagger()
+		if err != nil {
+			return "", err
+		}
+		return Generate(swagger, opts)
+	}
+
+	var expected []string
+	for _, opts := range configs {
+		code, err := generate(opts)
+		require.NoError(t, err)
+		expected = append(expected, code)
+	}
+	assert.Contains(t, expected[1], "type FindPetsResult struct {")
+	assert.NotContains(t, expected[0], "FindPetsResult")
+
+	// Generations with different options don't see each other's state.
+	const n = 8
+	results := make([]string, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = generate(configs[i%len(configs)])
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, expected[i%len(configs)], results[i])
+	}
+}
+
+func TestPackageLevelFunctions(t *testing.T) {
+	swagger, err := examplePetstore.GetSwagger()
+	require.NoError(t, err)
+
+	// The functions predating Generator generate with the default options.
+	ops, err := OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+	expected, err := NewGenerator(Configuration{}).OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+	assert.Equal(t, len(expected), len(ops))
+
+	schema, err := GenerateGoSchema(swagger.Components.Schemas["Pet"], []string{"Pet"})
+	require.NoError(t, err)
+	assert.Contains(t, GenStructFromSchema(schema), "Id int64")
+
+	goType, err := RefPathToGoType("#/components/schemas/NewPet")
+	require.NoError(t, err)
+	assert.Equal(t, "NewPet", goType)
+
+	tpl := template.New("oapi-codegen").Funcs(NewGenerator(Configuration{}).templateFunctions())
+	require.NoError(t, LoadTemplates(templates, tpl))
+	imports, err := GenerateImports(tpl, nil, "api")
+	require.NoError(t, err)
+	assert.Contains(t, imports, "// Package api provides primitives to interact with the openapi HTTP API.")
+}
+
+func TestMultipleServersWithStrictFiber(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			FiberServer: true,
+			EchoServer:  true,
+			Strict:      true,
+		},
+	}
+	assert.Error(t, opts.Validate())
+
+	opts.Generate.Strict = false
+	assert.NoError(t, opts.Validate())
+
+	swagger, err := examplePetstore.GetSwagger()
+	require.NoError(t, err)
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Fiber serves the shared ServerInterface through its net/http adaptor:
+	assert.Equal(t, 1, strings.Count(code, "type ServerInterface interface {"))
+	assert.Contains(t, code, "func RegisterFiberHandlers(router fiber.Router, si ServerInterface) {")
+	assert.Contains(t, code, "return adaptor.HTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestStdHTTPServerPaths(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: paths, version: 1.0.0}
+paths:
+  /pets/:
+    get:
+      operationId: listPets
+      responses:
+        '200': {description: ok}
+  /pets/{pet-id}:
+    get:
+      operationId: getPet
+      parameters:
+        - {name: pet-id, in: path, required: true, schema: {type: integer}}
+      responses:
+        '200': {description: ok}
+`
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			StdHTTPServer: true,
+			Models:        true,
+		},
+	}
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, `m.HandleFunc("GET "+options.BaseURL+"/pets/{$}", wrapper.ListPets)`)
+	assert.Contains(t, code, `m.HandleFunc("GET "+options.BaseURL+"/pets/{pet_id}", wrapper.GetPet)`)
+	assert.Contains(t, code, `r.PathValue("pet_id")`)
+	// Like chi, middlewares are applied last to first by default.
+	assert.Contains(t, code, "for _, middleware := range siw.HandlerMiddlewares {")
+	checkLint(t, "test.gen.go", []byte(code))
+
+	opts.Compatibility.ApplyChiMiddlewareFirstToLast = true
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {")
+	opts.Compatibility.ApplyChiMiddlewareFirstToLast = false
+
+	// ServeMux wildcards must have distinct names.
+	swagger.Paths["/pets/{pet-id}/{pet_id}"] = &openapi3.PathItem{Get: &openapi3.Operation{
+		OperationID: "getPetTwice",
+		Parameters: openapi3.Parameters{
+			{Value: openapi3.NewPathParameter("pet-id").WithSchema(openapi3.NewIntegerSchema())},
+			{Value: openapi3.NewPathParameter("pet_id").WithSchema(openapi3.NewIntegerSchema())},
+		},
+		Responses: openapi3.NewResponses(),
+	}}
+	_, err = Generate(swagger, opts)
+	assert.ErrorContains(t, err, `path /pets/{pet-id}/{pet_id} of GetPetTwice isn't supported by the std-http-server, as its parameters "pet-id" and "pet_id" have the same wildcard name pet_id`)
+	delete(swagger.Paths, "/pets/{pet-id}/{pet_id}")
+
+	// ServeMux wildcards must be whole path segments.
+	swagger.Paths["/pets/{pet-id}.json"] = swagger.Paths["/pets/{pet-id}"]
+	delete(swagger.Paths, "/pets/{pet-id}")
+	_, err = Generate(swagger, opts)
+	assert.ErrorContains(t, err, "path /pets/{pet-id}.json of GetPet isn't supported by the std-http-server")
+}
+
 func TestExamplePetStoreCodeGenerationWithUserTemplates(t *testing.T) {
 
 	userTemplates := map[string]string{"typedef.tmpl": "//blah\n//blah"}
@@ -437,3 +721,149 @@ func (t *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error
 
 //go:embed test_spec.yaml
 var testOpenAPIDefinition string
+
+func TestClientResponseErrorNames(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: errors, version: 1.0.0}
+paths:
+  /users:
+    post:
+      operationId: createUser
+      responses:
+        '204': {description: ok}
+        '400':
+          description: invalid
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/CreateUserError'}
+components:
+  schemas:
+    CreateUserError:
+      type: object
+      properties:
+        message: {type: string}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, Client: true},
+		OutputOptions: OutputOptions{
+			ClientResponseErrors: true,
+		},
+	}
+	_, err = Generate(swagger, opts)
+	assert.ErrorContains(t, err, "the name CreateUserError of the error type of operation CreateUser is taken by components/schemas/CreateUserError")
+
+	// The error types aren't generated without the option.
+	opts.OutputOptions.ClientResponseErrors = false
+	_, err = Generate(swagger, opts)
+	assert.NoError(t, err)
+}
+
+func TestStreamingResponses(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: streams, version: 1.0.0}
+paths:
+  /pets/events:
+    get:
+      operationId: watchPets
+      responses:
+        '200':
+          description: events
+          content:
+            text/event-stream:
+              schema: {type: string}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, StdHTTPServer: true, Strict: true},
+	}
+	// Streams keep the response objects of other contents by default.
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "type WatchPets200TexteventStreamResponse struct {\n\tBody          io.Reader")
+	assert.NotContains(t, code, "EventStreamWriter")
+
+	opts.OutputOptions.StreamingResponses = true
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "type WatchPets200EventStreamResponse struct {\n\tBody func(w *runtime.EventStreamWriter[string]) error")
+}
+
+func TestDefaultsOption(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: defaults, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema: {type: integer, default: 20}
+      responses:
+        '204':
+          description: no content
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, ChiServer: true},
+	}
+	// Neither the methods nor their calls are generated by default.
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.NotContains(t, code, "ApplyDefaults")
+
+	opts.Generate.Defaults = true
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func (t *ListPetsParams) ApplyDefaults() {")
+	assert.Contains(t, code, "params.ApplyDefaults()")
+}
+
+func TestGeneratorWarnings(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: warnings, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Credentials:
+      type: object
+      properties:
+        password: {type: string, pattern: '^(?=.*[0-9]).{8,}$'}
+        previous: {type: string, pattern: '^(?=.*[0-9]).{8,}$'}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	g := NewGenerator(Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, Validation: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	_, err = g.Generate(swagger)
+	require.NoError(t, err)
+	// Each warning is reported once.
+	require.Len(t, g.Warnings(), 1)
+	assert.Contains(t, g.Warnings()[0], `the pattern "^(?=.*[0-9]).{8,}$" isn't validated`)
+
+	// Warnings are those of the last generation.
+	for _, property := range swagger.Components.Schemas["Credentials"].Value.Properties {
+		property.Value.Pattern = "^[0-9]+$"
+	}
+	_, err = g.Generate(swagger)
+	require.NoError(t, err)
+	assert.Empty(t, g.Warnings())
+}
@@ -0,0 +1,171 @@
+package codegen
+
+import (
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The functions below are the package level API which predates Generator.
+// They generate with a Generator of the default options, as they did before
+// Generate was called, and keep working for the callers of this package
+// which don't need any option.
+
+// OperationDefinitions returns all operations for a swagger definition.
+//
+// Deprecated: use Generator.OperationDefinitions, which applies the options of
+// the Generator.
+func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
+	return NewGenerator(Configuration{}).OperationDefinitions(swagger, initialismOverrides)
+}
+
+// DescribeParameters walks the given parameters dictionary, and generates
+// their descriptors into a flat list.
+//
+// Deprecated: use Generator.DescribeParameters.
+func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+	return NewGenerator(Configuration{}).DescribeParameters(params, path)
+}
+
+// GetResponseTypeDefinitions produces a list of type definitions for the
+// response types of the operation which we know how to parse.
+//
+// Deprecated: use Generator.GetResponseTypeDefinitions.
+func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefinition, error) {
+	return NewGenerator(Configuration{}).GetResponseTypeDefinitions(o)
+}
+
+// GenerateBodyDefinitions turns the Swagger body definitions into a list of
+// our body definitions which will be used for code generation.
+//
+// Deprecated: use Generator.GenerateBodyDefinitions.
+func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateBodyDefinitions(operationID, bodyOrRef)
+}
+
+// GenerateResponseDefinitions turns the Swagger response definitions into a
+// list of our response definitions which will be used for code generation.
+//
+// Deprecated: use Generator.GenerateResponseDefinitions.
+func GenerateResponseDefinitions(operationID string, responses openapi3.Responses) ([]ResponseDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateResponseDefinitions(operationID, responses)
+}
+
+// GenerateTypeDefsForOperation returns the type definitions of the
+// parameters and bodies of an operation.
+//
+// Deprecated: use Generator.GenerateTypeDefsForOperation.
+func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
+	return NewGenerator(Configuration{}).GenerateTypeDefsForOperation(op)
+}
+
+// GenerateParamsTypes defines the schema for a parameters definition object
+// which encapsulates all the query, header and cookie parameters for an
+// operation.
+//
+// Deprecated: use Generator.GenerateParamsTypes.
+func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
+	return NewGenerator(Configuration{}).GenerateParamsTypes(op)
+}
+
+// GenerateTypeDefinitions generates the type definitions of the components
+// of the spec and of its operations.
+//
+// Deprecated: use Generator.GenerateTypeDefinitions.
+func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+	return NewGenerator(Configuration{}).GenerateTypeDefinitions(t, swagger, ops, excludeSchemas)
+}
+
+// GenerateTypesForSchemas generates type definitions for any custom types
+// defined in the components/schemas section of the Swagger spec.
+//
+// Deprecated: use Generator.GenerateTypesForSchemas.
+func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateTypesForSchemas(t, schemas, excludeSchemas)
+}
+
+// GenerateTypesForParameters generates type definitions for any custom types
+// defined in the components/parameters section of the Swagger spec.
+//
+// Deprecated: use Generator.GenerateTypesForParameters.
+func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateTypesForParameters(t, params)
+}
+
+// GenerateTypesForResponses generates type definitions for any custom types
+// defined in the components/responses section of the Swagger spec.
+//
+// Deprecated: use Generator.GenerateTypesForResponses.
+func GenerateTypesForResponses(t *template.Template, responses openapi3.Responses) ([]TypeDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateTypesForResponses(t, responses)
+}
+
+// GenerateTypesForRequestBodies generates type definitions for any custom
+// types defined in the components/requestBodies section of the Swagger spec.
+//
+// Deprecated: use Generator.GenerateTypesForRequestBodies.
+func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+	return NewGenerator(Configuration{}).GenerateTypesForRequestBodies(t, bodies)
+}
+
+// GenerateEnums generates the constants of the enums of types.
+//
+// Deprecated: use Generator.GenerateEnums.
+func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+	return NewGenerator(Configuration{}).GenerateEnums(t, types)
+}
+
+// GenerateImports generates our import statements and package definition,
+// along with the documentation of the package.
+//
+// Deprecated: use Generator.GenerateImports, which can leave out the
+// documentation of the package.
+func GenerateImports(t *template.Template, externalImports []string, packageName string) (string, error) {
+	return NewGenerator(Configuration{}).GenerateImports(t, externalImports, packageName, true)
+}
+
+// MergeSchemas merges all the fields in the schemas supplied into one giant
+// schema.
+//
+// Deprecated: use Generator.MergeSchemas.
+func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return NewGenerator(Configuration{}).MergeSchemas(allOf, path)
+}
+
+// GenStructFromAllOf generates an object that is the union of the objects in
+// the input array.
+//
+// Deprecated: use Generator.GenStructFromAllOf.
+func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
+	return NewGenerator(Configuration{}).GenStructFromAllOf(allOf, path)
+}
+
+// GenerateGoSchema generates the Go type of a schema.
+//
+// Deprecated: use Generator.GenerateGoSchema.
+func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return NewGenerator(Configuration{}).GenerateGoSchema(sref, path)
+}
+
+// GenFieldsFromProperties produce corresponding field names with JSON
+// annotations, given a list of schema descriptors.
+//
+// Deprecated: use Generator.GenFieldsFromProperties.
+func GenFieldsFromProperties(props []Property) []string {
+	return NewGenerator(Configuration{}).GenFieldsFromProperties(props)
+}
+
+// GenStructFromSchema generates the Go struct of an object schema.
+//
+// Deprecated: use Generator.GenStructFromSchema.
+func GenStructFromSchema(schema Schema) string {
+	return NewGenerator(Configuration{}).GenStructFromSchema(schema)
+}
+
+// RefPathToGoType takes a $ref value and converts it to a Go typename.
+//
+// Deprecated: use Generator.RefPathToGoType, which resolves the references
+// of the import mapping of its options.
+func RefPathToGoType(refPath string) (string, error) {
+	return NewGenerator(Configuration{}).RefPathToGoType(refPath)
+}
@@ -18,19 +18,29 @@ type Configuration struct {
 	OutputOptions     OutputOptions        `yaml:"output-options,omitempty"`
 	ImportMapping     map[string]string    `yaml:"import-mapping,omitempty"` // ImportMapping specifies the golang package path for each external reference
 	AdditionalImports []AdditionalImport   `yaml:"additional-imports,omitempty"`
+
+	// InputHash is recorded in the header of the generated files, so that
+	// tools can tell whether they are up to date with the inputs they were
+	// generated from. It isn't part of configuration files.
+	InputHash string `yaml:"-"`
 }
 
 // GenerateOptions specifies which supported output formats to generate.
 type GenerateOptions struct {
-	ChiServer     bool `yaml:"chi-server,omitempty"`     // ChiServer specifies whether to generate chi server boilerplate
-	FiberServer   bool `yaml:"fiber-server,omitempty"`   // FiberServer specifies whether to generate fiber server boilerplate
-	EchoServer    bool `yaml:"echo-server,omitempty"`    // EchoServer specifies whether to generate echo server boilerplate
-	GinServer     bool `yaml:"gin-server,omitempty"`     // GinServer specifies whether to generate gin server boilerplate
-	GorillaServer bool `yaml:"gorilla-server,omitempty"` // GorillaServer specifies whether to generate Gorilla server boilerplate
-	Strict        bool `yaml:"strict-server,omitempty"`  // Strict specifies whether to generate strict server wrapper
-	Client        bool `yaml:"client,omitempty"`         // Client specifies whether to generate client boilerplate
-	Models        bool `yaml:"models,omitempty"`         // Models specifies whether to generate type definitions
-	EmbeddedSpec  bool `yaml:"embedded-spec,omitempty"`  // Whether to embed the swagger spec in the generated code
+	ChiServer     bool `yaml:"chi-server,omitempty"`      // ChiServer specifies whether to generate chi server boilerplate
+	FiberServer   bool `yaml:"fiber-server,omitempty"`    // FiberServer specifies whether to generate fiber server boilerplate
+	EchoServer    bool `yaml:"echo-server,omitempty"`     // EchoServer specifies whether to generate echo server boilerplate
+	GinServer     bool `yaml:"gin-server,omitempty"`      // GinServer specifies whether to generate gin server boilerplate
+	GorillaServer bool `yaml:"gorilla-server,omitempty"`  // GorillaServer specifies whether to generate Gorilla server boilerplate
+	StdHTTPServer bool `yaml:"std-http-server,omitempty"` // StdHTTPServer specifies whether to generate net/http server boilerplate, using the ServeMux patterns of Go 1.22
+	Strict        bool `yaml:"strict-server,omitempty"`   // Strict specifies whether to generate strict server wrapper
+	MockServer    bool `yaml:"mock-server,omitempty"`     // MockServer specifies whether to generate an implementation of the strict server responding with examples
+	Client        bool `yaml:"client,omitempty"`          // Client specifies whether to generate client boilerplate
+	Models        bool `yaml:"models,omitempty"`          // Models specifies whether to generate type definitions
+	EmbeddedSpec  bool `yaml:"embedded-spec,omitempty"`   // Whether to embed the swagger spec in the generated code
+	Validation    bool `yaml:"validation,omitempty"`      // Validation specifies whether to generate Validate methods for the models
+	Defaults      bool `yaml:"defaults,omitempty"`        // Defaults specifies whether to generate ApplyDefaults methods for the models, which server wrappers call on the parameters
+	Callbacks     bool `yaml:"callbacks,omitempty"`       // Callbacks specifies whether to generate clients and servers for callbacks and webhooks
 }
 
 // CompatibilityOptions specifies backward compatibility settings for the
@@ -85,10 +95,15 @@ type OutputOptions struct {
 	ExcludeTags   []string          `yaml:"exclude-tags,omitempty"`   // Exclude operations that have one of these tags. Ignored when empty.
 	UserTemplates map[string]string `yaml:"user-templates,omitempty"` // Override built-in templates from user-provided files
 
-	ExcludeSchemas      []string `yaml:"exclude-schemas,omitempty"`      // Exclude from generation schemas with given names. Ignored when empty.
-	ResponseTypeSuffix  string   `yaml:"response-type-suffix,omitempty"` // The suffix used for responses types
-	ClientTypeName      string   `yaml:"client-type-name,omitempty"`     // Override the default generated client type with the value
-	InitialismOverrides bool     `yaml:"initialism-overrides,omitempty"` // Whether to use the initialism overrides
+	ExcludeSchemas       []string `yaml:"exclude-schemas,omitempty"`        // Exclude from generation schemas with given names. Ignored when empty.
+	ResponseTypeSuffix   string   `yaml:"response-type-suffix,omitempty"`   // The suffix used for responses types
+	ClientTypeName       string   `yaml:"client-type-name,omitempty"`       // Override the default generated client type with the value
+	InitialismOverrides  bool     `yaml:"initialism-overrides,omitempty"`   // Whether to use the initialism overrides
+	EnumTextMarshaling   bool     `yaml:"enum-text-marshaling,omitempty"`   // Whether to generate marshaling methods for enums which reject unknown values
+	NullableType         bool     `yaml:"nullable-type,omitempty"`          // Whether to use types.Nullable for nullable properties, to tell null from absent values
+	ReadWriteVariants    bool     `yaml:"read-write-variants,omitempty"`    // Whether to generate request and response variants of models with readOnly or writeOnly properties, for the bodies of operations
+	ClientResponseErrors bool     `yaml:"client-response-errors,omitempty"` // Whether ClientWithResponses returns responses with unsuccessful status codes as typed errors
+	StreamingResponses   bool     `yaml:"streaming-responses,omitempty"`    // Whether text/event-stream and application/x-ndjson responses are streams of values, written and read as they go
 }
 
 // UpdateDefaults sets reasonable default values for unset fields in Configuration
@@ -103,28 +118,31 @@ func (o Configuration) UpdateDefaults() Configuration {
 	return o
 }
 
+// ServerCount returns the number of server types which are to be generated.
+func (o GenerateOptions) ServerCount() int {
+	n := 0
+	for _, enabled := range []bool{o.ChiServer, o.FiberServer, o.EchoServer, o.GinServer, o.GorillaServer, o.StdHTTPServer} {
+		if enabled {
+			n++
+		}
+	}
+	return n
+}
+
 // Validate checks whether Configuration represent a valid configuration
 func (o Configuration) Validate() error {
 	if o.PackageName == "" {
 		return errors.New("package name must be specified")
 	}
 
-	// Only one server type should be specified at a time.
-	nServers := 0
-	if o.Generate.ChiServer {
-		nServers++
+	// Strict fiber servers use their own request and response objects, so
+	// they can't share a StrictServerInterface with other servers.
+	if o.Generate.Strict && o.Generate.FiberServer && o.Generate.ServerCount() > 1 {
+		return errors.New("strict-server with fiber-server can't be combined with other server types")
 	}
-	if o.Generate.FiberServer {
-		nServers++
-	}
-	if o.Generate.EchoServer {
-		nServers++
-	}
-	if o.Generate.GinServer {
-		nServers++
-	}
-	if nServers > 1 {
-		return errors.New("only one server type is supported at a time")
+
+	if o.Generate.MockServer && !o.Generate.Strict {
+		return errors.New("mock-server requires strict-server")
 	}
 	return nil
 }
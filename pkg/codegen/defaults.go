@@ -0,0 +1,338 @@
+package codegen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// DefaultsDefinition describes the ApplyDefaults method generated for a type.
+type DefaultsDefinition struct {
+	// TypeName is the name of the type which gets the method.
+	TypeName string
+	// Code is the body of the method, which sets the absent properties of t
+	// to their default values.
+	Code string
+}
+
+// GenerateDefaults generates an ApplyDefaults method for each of the given
+// types, as well as the types defined by the operations, which have properties
+// with default values in their schemas.
+func (g *Generator) GenerateDefaults(t *template.Template, types []TypeDefinition, ops []OperationDefinition) (string, error) {
+	types = withOperationTypes(types, ops)
+	dg := defaultsGenerator{Generator: g, types: typesByName(types)}
+
+	var definitions []DefaultsDefinition
+	for _, td := range types {
+		if td.IsAlias() || !hasDefaults(td.Schema) {
+			continue
+		}
+		definitions = append(definitions, DefaultsDefinition{
+			TypeName: td.TypeName,
+			Code:     strings.Join(dg.typeDefaults(td), "\n"),
+		})
+	}
+
+	return GenerateTemplates([]string{"defaults.tmpl"}, t, definitions)
+}
+
+// hasDefaults returns whether any of the properties of s, or of the values it
+// contains, has a default value. Types for which this is true get an
+// ApplyDefaults method.
+func hasDefaults(s Schema) bool {
+	visited := map[*openapi3.Schema]bool{}
+	for _, p := range s.Properties {
+		if p.Schema.OAPISchema != nil && p.Schema.OAPISchema.Default != nil {
+			return true
+		}
+		if hasNestedDefaults(p.Schema.OAPISchema, visited) {
+			return true
+		}
+	}
+	return hasNestedDefaults(s.OAPISchema, visited)
+}
+
+func hasNestedDefaults(s *openapi3.Schema, visited map[*openapi3.Schema]bool) bool {
+	if s == nil || visited[s] {
+		return false
+	}
+	visited[s] = true
+
+	for _, p := range s.Properties {
+		if p.Value != nil && (p.Value.Default != nil || hasNestedDefaults(p.Value, visited)) {
+			return true
+		}
+	}
+	for _, ref := range s.AllOf {
+		if hasNestedDefaults(ref.Value, visited) {
+			return true
+		}
+	}
+	if s.Items != nil && hasNestedDefaults(s.Items.Value, visited) {
+		return true
+	}
+	if s.AdditionalProperties.Schema != nil && hasNestedDefaults(s.AdditionalProperties.Schema.Value, visited) {
+		return true
+	}
+	return false
+}
+
+// defaultsGenerator generates the statements which set absent values to the
+// defaults of their schemas.
+type defaultsGenerator struct {
+	*Generator
+	// types holds all the type definitions of the package, by type name.
+	types map[string]TypeDefinition
+	// depth is the nesting depth of loops, used to name loop variables.
+	depth int
+}
+
+// typeDefaults generates the body of the ApplyDefaults method of td.
+func (g *defaultsGenerator) typeDefaults(td TypeDefinition) []string {
+	s := td.Schema
+	// A type defined from another type doesn't get its methods, so convert
+	// the value to the original type.
+	if name := s.TypeDecl(); name != td.TypeName && !isStructuralGoType(name) {
+		return g.valueDefaults(s, fmt.Sprintf("(*%s)(t)", name))
+	}
+	return g.valueDefaults(s, "*t")
+}
+
+// valueDefaults generates the statements which apply the defaults of the
+// schema s to the values within the addressable value of expr.
+func (g *defaultsGenerator) valueDefaults(s Schema, expr string) []string {
+	if s.OAPISchema != nil {
+		if _, ok := s.OAPISchema.Extensions[extPropGoType]; ok {
+			return nil
+		}
+	}
+
+	name := s.TypeDecl()
+	if !isStructuralGoType(name) {
+		td, found := g.types[name]
+		switch {
+		case !found:
+			// Types from other packages are left alone.
+			return nil
+		case td.IsAlias():
+			return g.valueDefaults(td.Schema, expr)
+		case hasDefaults(td.Schema):
+			return []string{selectorBase(expr) + ".ApplyDefaults()"}
+		default:
+			return nil
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(s.GoType, "struct {"):
+		return g.structDefaults(s, expr)
+	case s.ArrayType != nil:
+		index := g.loopVariable()
+		defer func() { g.depth-- }()
+		itemCode := g.valueDefaults(*s.ArrayType, fmt.Sprintf("%s[%s]", indexBase(expr), index))
+		if len(itemCode) == 0 {
+			return nil
+		}
+		code := []string{fmt.Sprintf("for %s := range %s {", index, expr)}
+		code = append(code, itemCode...)
+		return append(code, "}")
+	case strings.HasPrefix(s.GoType, "map[string]") && s.AdditionalPropertiesType != nil:
+		return g.mapDefaults(*s.AdditionalPropertiesType, expr)
+	default:
+		return nil
+	}
+}
+
+// structDefaults generates the statements which set the absent fields of the
+// struct value of expr to their defaults.
+func (g *defaultsGenerator) structDefaults(s Schema, expr string) []string {
+	var code []string
+	for _, p := range s.Properties {
+		fieldExpr := selectorBase(expr) + "." + structFieldName(p)
+		pointer := strings.HasPrefix(g.structFieldTypeDef(p), "*")
+
+		if g.isNullableField(p) {
+			// The value of a Nullable is a copy, which has to be set back.
+			value := g.valueVariable()
+			fieldCode := g.valueDefaults(p.Schema, value)
+			g.depth--
+			if len(fieldCode) != 0 {
+				code = append(code, fmt.Sprintf("if %s, err := %s.Get(); err == nil {", value, fieldExpr))
+				code = append(code, fieldCode...)
+				code = append(code, fmt.Sprintf("%s.Set(%s)", fieldExpr, value), "}")
+			}
+			continue
+		}
+
+		// Null is a value of nullable properties, so a nil pointer doesn't
+		// tell they're absent.
+		if p.Schema.OAPISchema != nil && p.Schema.OAPISchema.Default != nil && !p.Nullable {
+			if value, ok := g.defaultValue(p.Schema, p.Schema.OAPISchema.Default); ok {
+				switch {
+				case pointer:
+					code = append(code,
+						fmt.Sprintf("if %s == nil {", fieldExpr),
+						fmt.Sprintf("var defaultValue %s = %s", p.Schema.TypeDecl(), value),
+						fmt.Sprintf("%s = &defaultValue", fieldExpr),
+						"}")
+				case isNilable(g.types, p.Schema):
+					code = append(code,
+						fmt.Sprintf("if %s == nil {", fieldExpr),
+						fmt.Sprintf("%s = %s", fieldExpr, value),
+						"}")
+				}
+			}
+		}
+
+		if !pointer {
+			code = append(code, g.valueDefaults(p.Schema, fieldExpr)...)
+			continue
+		}
+		if fieldCode := g.valueDefaults(p.Schema, "*"+fieldExpr); len(fieldCode) != 0 {
+			code = append(code, fmt.Sprintf("if %s != nil {", fieldExpr))
+			code = append(code, fieldCode...)
+			code = append(code, "}")
+		}
+	}
+
+	if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil {
+		code = append(code, g.mapDefaults(*s.AdditionalPropertiesType, selectorBase(expr)+".AdditionalProperties")...)
+	}
+	return code
+}
+
+// mapDefaults generates the statements which apply the defaults of
+// valueSchema to the values of the map value of expr.
+func (g *defaultsGenerator) mapDefaults(valueSchema Schema, expr string) []string {
+	key := g.loopVariable()
+	defer func() { g.depth-- }()
+	value := fmt.Sprintf("v%d", g.depth)
+
+	if valueSchema.OAPISchema != nil && valueSchema.OAPISchema.Nullable {
+		valueCode := g.valueDefaults(valueSchema, "*"+value)
+		if len(valueCode) == 0 {
+			return nil
+		}
+		code := []string{fmt.Sprintf("for _, %s := range %s {", value, expr), fmt.Sprintf("if %s != nil {", value)}
+		code = append(code, valueCode...)
+		return append(code, "}", "}")
+	}
+
+	// Map values aren't addressable, so they're updated through a copy.
+	valueCode := g.valueDefaults(valueSchema, value)
+	if len(valueCode) == 0 {
+		return nil
+	}
+	code := []string{fmt.Sprintf("for %s, %s := range %s {", key, value, expr)}
+	code = append(code, valueCode...)
+	return append(code, fmt.Sprintf("%s[%s] = %s", indexBase(expr), key, value), "}")
+}
+
+// defaultValue returns the Go expression of the default value of a property
+// of schema s. It returns false for values of types which can't be written
+// as literals, such as objects, and for values which don't match the type
+// of the schema.
+func (g *defaultsGenerator) defaultValue(s Schema, value interface{}) (string, bool) {
+	goType := s.TypeDecl()
+	resolved := g.resolve(s)
+
+	switch {
+	case resolved.GoType == "string":
+		str, ok := value.(string)
+		return strconv.Quote(str), ok
+	case resolved.GoType == "bool":
+		b, ok := value.(bool)
+		return strconv.FormatBool(b), ok
+	case isNumericGoType(resolved.GoType):
+		f, ok := value.(float64)
+		if !ok {
+			return "", false
+		}
+		if !strings.HasPrefix(resolved.GoType, "float") && f != math.Trunc(f) {
+			return "", false
+		}
+		return formatFloat(f), true
+	case goType == "time.Time":
+		str, _ := value.(string)
+		t, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return "", false
+		}
+		t = t.UTC()
+		return fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, %d, %d, time.UTC)",
+			t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()), true
+	case goType == "openapi_types.Date":
+		str, _ := value.(string)
+		t, err := time.Parse(types.DateFormat, str)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("openapi_types.Date{Time: time.Date(%d, %d, %d, 0, 0, 0, 0, time.UTC)}",
+			t.Year(), t.Month(), t.Day()), true
+	case strings.HasPrefix(resolved.GoType, "[]") && resolved.ArrayType != nil:
+		items, ok := value.([]interface{})
+		if !ok {
+			return "", false
+		}
+		values := make([]string, len(items))
+		for i, item := range items {
+			if values[i], ok = g.defaultValue(*resolved.ArrayType, item); !ok {
+				return "", false
+			}
+		}
+		return fmt.Sprintf("%s{%s}", resolved.GoType, strings.Join(values, ", ")), true
+	default:
+		return "", false
+	}
+}
+
+// resolve returns the schema of the Go type which s refers to by name, if it's
+// defined in this package.
+func (g *defaultsGenerator) resolve(s Schema) Schema {
+	for i := 0; i < len(g.types); i++ {
+		if s.OAPISchema != nil {
+			if _, ok := s.OAPISchema.Extensions[extPropGoType]; ok {
+				return s
+			}
+		}
+		name := s.TypeDecl()
+		td, found := g.types[name]
+		if isStructuralGoType(name) || !found {
+			return s
+		}
+		s = td.Schema
+	}
+	return s
+}
+
+// loopVariable returns the name of the index or key variable of a new nested
+// loop. The caller has to decrement g.depth when it's done generating the
+// loop body.
+func (g *defaultsGenerator) loopVariable() string {
+	g.depth++
+	return fmt.Sprintf("k%d", g.depth)
+}
+
+// valueVariable returns the name of a variable holding a copy of a nested
+// value. The caller has to decrement g.depth when it's done generating the
+// code using the variable.
+func (g *defaultsGenerator) valueVariable() string {
+	g.depth++
+	return fmt.Sprintf("v%d", g.depth)
+}
+
+// indexBase returns the expression to use with an index of the value of expr,
+// which has to be parenthesized when it's dereferenced.
+func indexBase(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
@@ -22,6 +22,10 @@ const (
 	extEnumVarNames      = "x-enum-varnames"
 	extEnumNames         = "x-enumNames"
 	extDeprecationReason = "x-deprecated-reason"
+	// extRetryable overrides whether clients may retry an operation.
+	extRetryable = "x-retryable"
+	// extPagination describes how clients follow the pages of an operation.
+	extPagination = "x-pagination"
 )
 
 func extString(extPropValue interface{}) (string, error) {
@@ -99,3 +103,27 @@ func extParseEnumVarNames(extPropValue interface{}) ([]string, error) {
 func extParseDeprecationReason(extPropValue interface{}) (string, error) {
 	return extString(extPropValue)
 }
+
+func extParseRetryable(extPropValue interface{}) (bool, error) {
+	retryable, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return retryable, nil
+}
+
+func extParsePagination(extPropValue interface{}) (map[string]string, error) {
+	paginationI, ok := extPropValue.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	pagination := make(map[string]string, len(paginationI))
+	for k, v := range paginationI {
+		vs, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to convert type: %T", v)
+		}
+		pagination[k] = vs
+	}
+	return pagination, nil
+}
@@ -0,0 +1,80 @@
+package codegen
+
+import (
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Hooks customize the code of a Generator from Go, rather than through user
+// templates. The Generator calls them between the stages of a generation, and
+// what they change is what the following stages generate code for. Any of them
+// may be nil.
+type Hooks struct {
+	// AfterSpecLoad is called with the spec before any code is generated
+	// from it, and before operations are filtered by tags and unused
+	// components are pruned. It may change the spec.
+	AfterSpecLoad func(spec *openapi3.T) error
+
+	// AfterOperationDefinitions is called with the definitions of the
+	// operations of the paths, and returns those to generate code for, so it
+	// may drop or change operations. The types of the parameters and bodies
+	// of an operation are already in its TypeDefinitions, so changing its
+	// OperationId doesn't rename them.
+	AfterOperationDefinitions func(ops []OperationDefinition) ([]OperationDefinition, error)
+
+	// AfterTypeDefinitions is called with the definitions of the types of
+	// the components, and returns those to generate code for. Types are
+	// referred to by name, so renaming a type doesn't change the references
+	// to it. When changing the properties of a struct, its Schema.GoType needs
+	// to be generated again, with Generator.GenStructFromSchema.
+	AfterTypeDefinitions func(types []TypeDefinition) ([]TypeDefinition, error)
+
+	// TemplateFunctions are added to the functions available to templates,
+	// and override the built-in functions of the same names.
+	TemplateFunctions template.FuncMap
+}
+
+// AddHooks registers hooks with g. Hooks are called in the order they are
+// registered, each with the result of the previous ones.
+func (g *Generator) AddHooks(hooks Hooks) {
+	g.hooks = append(g.hooks, hooks)
+}
+
+// afterSpecLoad calls the AfterSpecLoad hooks of g.
+func (g *Generator) afterSpecLoad(spec *openapi3.T) error {
+	for _, hooks := range g.hooks {
+		if hooks.AfterSpecLoad != nil {
+			if err := hooks.AfterSpecLoad(spec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// afterOperationDefinitions calls the AfterOperationDefinitions hooks of g.
+func (g *Generator) afterOperationDefinitions(ops []OperationDefinition) ([]OperationDefinition, error) {
+	for _, hooks := range g.hooks {
+		if hooks.AfterOperationDefinitions != nil {
+			var err error
+			if ops, err = hooks.AfterOperationDefinitions(ops); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ops, nil
+}
+
+// afterTypeDefinitions calls the AfterTypeDefinitions hooks of g.
+func (g *Generator) afterTypeDefinitions(types []TypeDefinition) ([]TypeDefinition, error) {
+	for _, hooks := range g.hooks {
+		if hooks.AfterTypeDefinitions != nil {
+			var err error
+			if types, err = hooks.AfterTypeDefinitions(types); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return types, nil
+}
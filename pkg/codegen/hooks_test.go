@@ -0,0 +1,114 @@
+package codegen
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hooksSpec = `
+openapi: 3.0.3
+info: {title: hooks, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items: {$ref: '#/components/schemas/Pet'}
+  /pets/{id}:
+    delete:
+      operationId: deletePet
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+      responses:
+        '204': {description: deleted}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name: {type: string}
+    Owner:
+      type: object
+      properties:
+        name: {type: string}
+`
+
+func TestHooks(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(hooksSpec))
+	require.NoError(t, err)
+
+	g := NewGenerator(Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Client: true, Models: true},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+			UserTemplates: map[string]string{
+				"client.tmpl": "{{range .}}// {{shout .OperationId}}\n{{end}}",
+			},
+		},
+	})
+	g.AddHooks(Hooks{
+		AfterSpecLoad: func(spec *openapi3.T) error {
+			spec.Components.Schemas["Owner"].Value.Description = "Owns pets."
+			return nil
+		},
+		AfterOperationDefinitions: func(ops []OperationDefinition) ([]OperationDefinition, error) {
+			var kept []OperationDefinition
+			for _, op := range ops {
+				if op.Method != "DELETE" {
+					kept = append(kept, op)
+				}
+			}
+			return kept, nil
+		},
+		TemplateFunctions: template.FuncMap{"shout": strings.ToUpper},
+	})
+	g.AddHooks(Hooks{
+		AfterTypeDefinitions: func(types []TypeDefinition) ([]TypeDefinition, error) {
+			for i, typ := range types {
+				if typ.TypeName != "Owner" {
+					continue
+				}
+				types[i].TypeName = "PetOwner"
+				types[i].Schema.Properties = append(types[i].Schema.Properties, Property{
+					JsonFieldName: "pets",
+					Schema:        Schema{GoType: "[]Pet"},
+				})
+				types[i].Schema.GoType = g.GenStructFromSchema(types[i].Schema)
+			}
+			return types, nil
+		},
+	})
+
+	code, err := g.Generate(swagger)
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "// PetOwner Owns pets.\ntype PetOwner struct {")
+	assert.Contains(t, code, "Pets *[]Pet  `json:\"pets,omitempty\"`")
+	assert.NotContains(t, code, "type Owner struct")
+	assert.Contains(t, code, "// LISTPETS\n")
+	assert.NotContains(t, code, "DELETEPET")
+	assert.NotContains(t, code, "DeletePet")
+
+	// Errors of hooks stop the generation.
+	g = NewGenerator(Configuration{PackageName: "api", Generate: GenerateOptions{Models: true}})
+	g.AddHooks(Hooks{
+		AfterTypeDefinitions: func(types []TypeDefinition) ([]TypeDefinition, error) {
+			return nil, errors.New("no types today")
+		},
+	})
+	_, err = g.Generate(swagger)
+	assert.ErrorContains(t, err, "no types today")
+}
@@ -0,0 +1,311 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Ways in which a mock server fills in the content of a response, depending on
+// its Go type in the strict server.
+const (
+	mockContentText      = "text"      // The content is converted from the example
+	mockContentJSON      = "json"      // The content is unmarshalled from the example
+	mockContentMultipart = "multipart" // The content is written by an empty func
+	mockContentReader    = "reader"    // The content is read from the example
+	mockContentStream    = "stream"    // The example is unmarshalled and written as the only value of the stream
+)
+
+// MockOperationDefinition describes how a mock server responds to an
+// operation.
+type MockOperationDefinition struct {
+	OperationId string
+	Responses   []MockResponseDefinition
+}
+
+// MockResponseDefinition describes a response of an operation which a mock
+// server may respond with, along with the examples of its content.
+type MockResponseDefinition struct {
+	StatusCode  string
+	ContentType string
+	Examples    []MockExampleDefinition
+
+	// TypeName is the type of the response object of the strict server.
+	TypeName string
+	// Content is the way in which the content is filled in, which is one of
+	// the mockContent constants, or empty when the response has no content.
+	Content string
+	// BodyField is the path to the content within the response object,
+	// which is empty when the response object is the content itself.
+	BodyField string
+	// BodyType is the Go type of the content, or of its values when it's
+	// streamed.
+	BodyType string
+	// StreamWriter is the type of the runtime writer of streamed contents.
+	StreamWriter string
+	// HasStatusCode and HasContentType specify whether the status code and
+	// content type of the response object are set from the request.
+	HasStatusCode  bool
+	HasContentType bool
+}
+
+// MockExampleDefinition is a named example of the content of a response, as
+// it is written in the body.
+type MockExampleDefinition struct {
+	Name  string
+	Value string
+}
+
+// GenerateMockServer generates an implementation of the strict server
+// interface which responds with the examples of the spec.
+func GenerateMockServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	mockOperations := make([]MockOperationDefinition, 0, len(operations))
+	for _, op := range operations {
+		mockOperations = append(mockOperations, mockOperationDefinition(op, opts.Generate.FiberServer))
+	}
+	return GenerateTemplates([]string{"strict/strict-mock.tmpl"}, t, mockOperations)
+}
+
+// mockOperationDefinition describes the responses of an operation, mirroring
+// the response objects generated for the strict server.
+func mockOperationDefinition(op OperationDefinition, fiber bool) MockOperationDefinition {
+	mockOperation := MockOperationDefinition{OperationId: op.OperationId}
+	for _, response := range op.Responses {
+		fixedStatusCode := response.HasFixedStatusCode()
+		hasHeaders := len(response.Headers) != 0
+
+		if len(response.Contents) == 0 {
+			mockOperation.Responses = append(mockOperation.Responses, MockResponseDefinition{
+				StatusCode:    response.StatusCode,
+				TypeName:      op.OperationId + response.StatusCode + "Response",
+				HasStatusCode: !fixedStatusCode,
+			})
+			continue
+		}
+
+		var spec *openapi3.Response
+		if op.Spec != nil {
+			if ref := op.Spec.Responses[response.StatusCode]; ref != nil {
+				spec = ref.Value
+			}
+		}
+
+		for _, content := range response.Contents {
+			var mediaType *openapi3.MediaType
+			if spec != nil {
+				mediaType = spec.Content[content.ContentType]
+			}
+			mockResponse := MockResponseDefinition{
+				StatusCode:     response.StatusCode,
+				ContentType:    content.ContentType,
+				TypeName:       op.OperationId + response.StatusCode + content.NameTagOrContentType() + "Response",
+				Content:        mockContentKind(content),
+				BodyType:       content.Schema.TypeDecl(),
+				HasStatusCode:  !fixedStatusCode,
+				HasContentType: !content.HasFixedContentType(),
+			}
+
+			switch {
+			case content.NameTag == "Text" && !fiber:
+				// The response object is a string.
+				mockResponse.Content = mockContentText
+				mockResponse.HasStatusCode = false
+			case fixedStatusCode && response.IsRef():
+				// The response object embeds that of the referenced
+				// response, which has no status code.
+				ref := response.Ref
+				if i := strings.LastIndex(ref, "."); i >= 0 {
+					ref = ref[i+1:]
+				}
+				mockResponse.BodyField = "." + UppercaseFirstCharacter(ref) + content.NameTagOrContentType() + "Response"
+				if hasHeaders || !content.IsSupported() || content.IsStreaming() {
+					mockResponse.BodyField += ".Body"
+				}
+			case !hasHeaders && fixedStatusCode && content.IsSupported() && !content.IsStreaming():
+				// The response object is the content itself.
+			default:
+				mockResponse.BodyField = ".Body"
+			}
+			if mockResponse.BodyField == "" {
+				mockResponse.BodyType = mockResponse.TypeName
+			}
+			if content.IsStreaming() {
+				mockResponse.StreamWriter = "runtime." + content.NameTag + "Writer[" + mockResponse.BodyType + "]"
+			}
+			mockResponse.Examples = mockExamples(mediaType, mockResponse.Content)
+			mockOperation.Responses = append(mockOperation.Responses, mockResponse)
+		}
+	}
+	return mockOperation
+}
+
+// mockContentKind returns the way in which the content of a response object
+// is filled in from an example.
+func mockContentKind(content ResponseContentDefinition) string {
+	switch {
+	case !content.IsSupported():
+		return mockContentReader
+	case content.NameTag == "Multipart":
+		return mockContentMultipart
+	case content.IsStreaming():
+		return mockContentStream
+	case content.NameTag == "Text" && content.Schema.OAPISchema != nil && content.Schema.OAPISchema.Type == "string":
+		return mockContentText
+	default:
+		return mockContentJSON
+	}
+}
+
+// mockExamples returns the examples of the content of a response, which are
+// those of its media type when there are any, or else one derived from its
+// schema. They are written as JSON when the content is unmarshalled.
+func mockExamples(mediaType *openapi3.MediaType, kind string) []MockExampleDefinition {
+	var values []MockExampleDefinition
+	if mediaType != nil {
+		if mediaType.Example != nil {
+			values = append(values, MockExampleDefinition{Value: mockExampleValue(mediaType.Example, kind)})
+		}
+		names := make([]string, 0, len(mediaType.Examples))
+		for name, example := range mediaType.Examples {
+			if example != nil && example.Value != nil && example.Value.Value != nil {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			values = append(values, MockExampleDefinition{Name: name, Value: mockExampleValue(mediaType.Examples[name].Value.Value, kind)})
+		}
+	}
+	if len(values) != 0 || kind == mockContentMultipart {
+		return values
+	}
+
+	var schema *openapi3.SchemaRef
+	if mediaType != nil {
+		schema = mediaType.Schema
+	}
+	sample := mockSample(schema, map[*openapi3.Schema]bool{})
+	if sample == nil && !mockUnmarshalled(kind) {
+		return nil
+	}
+	return []MockExampleDefinition{{Value: mockExampleValue(sample, kind)}}
+}
+
+// mockExampleValue returns an example as it is written in a body, which is
+// the string itself for strings which aren't unmarshalled, and JSON otherwise.
+func mockExampleValue(example interface{}, kind string) string {
+	if s, ok := example.(string); ok && !mockUnmarshalled(kind) {
+		return s
+	}
+	value, err := json.Marshal(example)
+	if err != nil {
+		return fmt.Sprint(example)
+	}
+	return string(value)
+}
+
+// mockSample returns a sample value for a schema, built from its examples,
+// defaults and constraints. The schemas in visited are those being sampled, to
+// break recursive references.
+func mockSample(schemaRef *openapi3.SchemaRef, visited map[*openapi3.Schema]bool) interface{} {
+	if schemaRef == nil || schemaRef.Value == nil {
+		return nil
+	}
+	schema := schemaRef.Value
+	if visited[schema] {
+		return nil
+	}
+	visited[schema] = true
+	defer delete(visited, schema)
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) != 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) != 0:
+		merged := map[string]interface{}{}
+		for _, s := range schema.AllOf {
+			if sample, ok := mockSample(s, visited).(map[string]interface{}); ok {
+				for name, value := range sample {
+					merged[name] = value
+				}
+			}
+		}
+		if sample, ok := mockObjectSample(schema, visited).(map[string]interface{}); ok {
+			for name, value := range sample {
+				merged[name] = value
+			}
+		}
+		return merged
+	case len(schema.OneOf) != 0:
+		return mockSample(schema.OneOf[0], visited)
+	case len(schema.AnyOf) != 0:
+		return mockSample(schema.AnyOf[0], visited)
+	}
+
+	switch schema.Type {
+	case "object":
+		return mockObjectSample(schema, visited)
+	case "array":
+		if item := mockSample(schema.Items, visited); item != nil {
+			return []interface{}{item}
+		}
+		return []interface{}{}
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return "2006-01-02T15:04:05Z"
+		case "date":
+			return "2006-01-02"
+		case "uuid":
+			return "00000000-0000-0000-0000-000000000000"
+		case "email":
+			return "user@example.com"
+		}
+		return "string"
+	case "integer":
+		if schema.Min != nil {
+			return int64(*schema.Min)
+		}
+		return 0
+	case "number":
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 0
+	case "boolean":
+		return true
+	}
+	if len(schema.Properties) != 0 {
+		return mockObjectSample(schema, visited)
+	}
+	return nil
+}
+
+// mockObjectSample returns a sample value for the properties of an object,
+// leaving out those which are only written by clients.
+func mockObjectSample(schema *openapi3.Schema, visited map[*openapi3.Schema]bool) interface{} {
+	sample := map[string]interface{}{}
+	for name, property := range schema.Properties {
+		if property == nil || property.Value == nil || property.Value.WriteOnly {
+			continue
+		}
+		if value := mockSample(property, visited); value != nil {
+			sample[name] = value
+		}
+	}
+	return sample
+}
+
+// mockUnmarshalled returns whether the examples of a kind of content are
+// unmarshalled from JSON.
+func mockUnmarshalled(kind string) bool {
+	return kind == mockContentJSON || kind == mockContentStream
+}
@@ -0,0 +1,46 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockSample(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.3
+info: {title: mock, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        name: {type: string, example: root}
+        children:
+          type: array
+          items: {$ref: '#/components/schemas/Node'}
+    Named:
+      allOf:
+        - {$ref: '#/components/schemas/Node'}
+        - type: object
+          properties:
+            id: {type: string, format: uuid}
+    Either:
+      oneOf:
+        - {type: number, minimum: 1.5}
+        - {type: boolean}
+`))
+	require.NoError(t, err)
+	schemas := spec.Components.Schemas
+
+	sample := func(name string) string {
+		return mockExampleValue(mockSample(schemas[name], map[*openapi3.Schema]bool{}), mockContentJSON)
+	}
+	// Recursive references are left out.
+	assert.JSONEq(t, `{"name":"root","children":[]}`, sample("Node"))
+	assert.JSONEq(t, `{"name":"root","children":[],"id":"00000000-0000-0000-0000-000000000000"}`, sample("Named"))
+	assert.Equal(t, "1.5", sample("Either"))
+}
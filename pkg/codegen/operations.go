@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -152,12 +153,12 @@ func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
 // DescribeParameters walks the given parameters dictionary, and generates the above
 // descriptors into a flat list. This makes it a lot easier to traverse the
 // data in the template engine.
-func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+func (g *Generator) DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
 	outParams := make([]ParameterDefinition, 0)
 	for _, paramOrRef := range params {
 		param := paramOrRef.Value
 
-		goType, err := paramToGoType(param, append(path, param.Name))
+		goType, err := g.paramToGoType(param, append(path, param.Name))
 		if err != nil {
 			return nil, fmt.Errorf("error generating type for param (%s): %s",
 				param.Name, err)
@@ -175,7 +176,7 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 		// name as the type. $ref: "#/components/schemas/custom_type" becomes
 		// "CustomType".
 		if IsGoTypeReference(paramOrRef.Ref) {
-			goType, err := RefPathToGoType(paramOrRef.Ref)
+			goType, err := g.RefPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s",
 					paramOrRef.Ref, param.Name, err)
@@ -205,23 +206,39 @@ func DescribeSecurityDefinition(securityRequirements openapi3.SecurityRequiremen
 	return outDefs
 }
 
+// DescribeSecurityRequirements describes security requirements as the
+// alternative sets of security schemes which they are made of, in order. An
+// empty set allows anonymous requests.
+func DescribeSecurityRequirements(securityRequirements openapi3.SecurityRequirements) [][]SecurityDefinition {
+	outReqs := make([][]SecurityDefinition, 0, len(securityRequirements))
+	for _, sr := range securityRequirements {
+		outReqs = append(outReqs, DescribeSecurityDefinition(openapi3.SecurityRequirements{sr}))
+	}
+	return outReqs
+}
+
 // OperationDefinition describes an Operation
 type OperationDefinition struct {
 	OperationId string // The operation_id description from Swagger, used to generate function names
 
-	PathParams          []ParameterDefinition // Parameters in the path, eg, /path/:param
-	HeaderParams        []ParameterDefinition // Parameters in HTTP headers
-	QueryParams         []ParameterDefinition // Parameters in the query, /path?param
-	CookieParams        []ParameterDefinition // Parameters in cookies
-	TypeDefinitions     []TypeDefinition      // These are all the types we need to define for this operation
-	SecurityDefinitions []SecurityDefinition  // These are the security providers
-	BodyRequired        bool
-	Bodies              []RequestBodyDefinition // The list of bodies for which to generate handlers.
-	Responses           []ResponseDefinition    // The list of responses that can be accepted by handlers.
-	Summary             string                  // Summary string from Swagger, used to generate a comment
-	Method              string                  // GET, POST, DELETE, etc.
-	Path                string                  // The Swagger path for the operation, like /resource/{id}
-	Spec                *openapi3.Operation
+	PathParams           []ParameterDefinition  // Parameters in the path, eg, /path/:param
+	HeaderParams         []ParameterDefinition  // Parameters in HTTP headers
+	QueryParams          []ParameterDefinition  // Parameters in the query, /path?param
+	CookieParams         []ParameterDefinition  // Parameters in cookies
+	TypeDefinitions      []TypeDefinition       // These are all the types we need to define for this operation
+	SecurityDefinitions  []SecurityDefinition   // These are the security providers
+	SecurityRequirements [][]SecurityDefinition // The alternative sets of security providers, of which clients satisfy the first they can
+	BodyRequired         bool
+	Bodies               []RequestBodyDefinition // The list of bodies for which to generate handlers.
+	Responses            []ResponseDefinition    // The list of responses that can be accepted by handlers.
+	Summary              string                  // Summary string from Swagger, used to generate a comment
+	Method               string                  // GET, POST, DELETE, etc.
+	Path                 string                  // The Swagger path for the operation, like /resource/{id}
+	Spec                 *openapi3.Operation
+	Callback             *CallbackDefinition   // The callback or webhook of the operation, if it's one of them
+	Servers              []ServerDefinition    // The servers of the operation, when it overrides the servers of the API
+	Retryable            bool                  // Whether clients may retry the operation, which they do for idempotent methods unless overridden by x-retryable
+	Pagination           *PaginationDefinition // How clients follow the pages of the operation, from its x-pagination extension
 }
 
 // Params returns the list of all parameters except Path parameters. Path parameters
@@ -247,6 +264,18 @@ func (o *OperationDefinition) RequiresParamObject() bool {
 	return len(o.Params()) > 0
 }
 
+// ParamsHaveDefaults returns true when the parameter object has parameters
+// with default values, so that it gets an ApplyDefaults method, which server
+// wrappers call to fill in the parameters which weren't provided.
+func (o *OperationDefinition) ParamsHaveDefaults() bool {
+	for _, td := range o.TypeDefinitions {
+		if td.TypeName == o.OperationId+"Params" {
+			return hasDefaults(td.Schema)
+		}
+	}
+	return false
+}
+
 // HasBody is called by the template engine to determine whether to generate body
 // marshaling code on the client. This is true for all body types, whether
 // we generate types for them.
@@ -254,6 +283,40 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// HasStreamingResponses returns whether any response of the operation streams
+// its content, in which case strict servers write it until the request is
+// done.
+func (o *OperationDefinition) HasStreamingResponses() bool {
+	for _, response := range o.Responses {
+		for _, content := range response.Contents {
+			if content.IsStreaming() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// StreamingContents returns the streaming contents of the successful responses
+// of the operation, one per content type, for which ClientWithResponses reads
+// the stream as it's received.
+func (o *OperationDefinition) StreamingContents() []ResponseContentDefinition {
+	var contents []ResponseContentDefinition
+	seen := make(map[string]bool)
+	for _, response := range o.Responses {
+		if !strings.HasPrefix(response.StatusCode, "2") {
+			continue
+		}
+		for _, content := range response.Contents {
+			if content.IsStreaming() && !seen[content.ContentType] {
+				seen[content.ContentType] = true
+				contents = append(contents, content)
+			}
+		}
+	}
+	return contents
+}
+
 // SummaryAsComment returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -271,7 +334,7 @@ func (o *OperationDefinition) SummaryAsComment() string {
 // types which we know how to parse. These will be turned into fields on a
 // response object for automatic deserialization of responses in the generated
 // Client code. See "client-with-responses.tmpl".
-func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefinition, error) {
+func (g *Generator) GetResponseTypeDefinitions(o *OperationDefinition) ([]ResponseTypeDefinition, error) {
 	var tds []ResponseTypeDefinition
 
 	responses := o.Spec.Responses
@@ -286,7 +349,7 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 				contentType := responseRef.Value.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
+					responseSchema, err := g.GenerateGoSchema(contentType.Schema, []string{responseName})
 					if err != nil {
 						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
 					}
@@ -324,7 +387,7 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 						ContentTypeName: contentTypeName,
 					}
 					if IsGoTypeReference(responseRef.Ref) {
-						refType, err := RefPathToGoType(responseRef.Ref)
+						refType, err := g.RefPathToGoType(responseRef.Ref)
 						if err != nil {
 							return nil, fmt.Errorf("error dereferencing response Ref: %w", err)
 						}
@@ -470,6 +533,12 @@ func (r ResponseContentDefinition) IsSupported() bool {
 	return r.NameTag != ""
 }
 
+// IsStreaming returns whether the content is a stream of values described by
+// its schema, which are server-sent events or JSON lines.
+func (r ResponseContentDefinition) IsStreaming() bool {
+	return r.NameTag == "EventStream" || r.NameTag == "NDJSON"
+}
+
 // HasFixedContentType returns true if content type has fixed content type, i.e. contains no "*" symbol
 func (r ResponseContentDefinition) HasFixedContentType() bool {
 	return !strings.Contains(r.ContentType, "*")
@@ -509,7 +578,7 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 }
 
 // OperationDefinitions returns all operations for a swagger definition.
-func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
+func (g *Generator) OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
 	var toCamelCaseFunc func(string) string
@@ -523,7 +592,7 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 		pathItem := swagger.Paths[requestPath]
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
-		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
+		globalParams, err := g.DescribeParameters(pathItem.Parameters, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error describing global parameters for %s: %s",
 				requestPath, err)
@@ -533,7 +602,8 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 		pathOps := pathItem.Operations()
 		for _, opName := range SortedOperationsKeys(pathOps) {
 			op := pathOps[opName]
-			if pathItem.Servers != nil {
+			// The servers of operations override those of their path.
+			if op.Servers == nil && pathItem.Servers != nil {
 				op.Servers = &pathItem.Servers
 			}
 			// We rely on OperationID to generate function names, it's required
@@ -546,79 +616,122 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 			} else {
 				op.OperationID = toCamelCaseFunc(op.OperationID)
 			}
-			op.OperationID = typeNamePrefix(op.OperationID) + op.OperationID
 
-			// These are parameters defined for the specific path method that
-			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
-			if err != nil {
-				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
-					opName, requestPath, err)
-			}
-			// All the parameters required by a handler are the union of the
-			// global parameters and the local parameters.
-			allParams := append(globalParams, localParams...)
-
-			// Order the path parameters to match the order as specified in
-			// the path, not in the swagger spec, and validate that the parameter
-			// names match, as downstream code depends on that.
-			pathParams := FilterParameterDefinitionByType(allParams, "path")
-			pathParams, err = SortParamsByPath(requestPath, pathParams)
+			opDef, err := g.describeOperation(swagger, requestPath, opName, op, globalParams, toCamelCaseFunc)
 			if err != nil {
 				return nil, err
 			}
+			operations = append(operations, *opDef)
+		}
+	}
+	return operations, nil
+}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
-			if err != nil {
-				return nil, fmt.Errorf("error generating body definitions: %w", err)
-			}
+// describeOperation describes the operation op, with the method opName, of
+// the path item at requestPath. The operation ID of op is expected to be set
+// already.
+func (g *Generator) describeOperation(swagger *openapi3.T, requestPath string, opName string, op *openapi3.Operation, globalParams []ParameterDefinition, toCamelCaseFunc func(string) string) (*OperationDefinition, error) {
+	op.OperationID = typeNamePrefix(op.OperationID) + op.OperationID
 
-			responseDefinitions, err := GenerateResponseDefinitions(op.OperationID, op.Responses)
-			if err != nil {
-				return nil, fmt.Errorf("error generating response definitions: %w", err)
-			}
+	// These are parameters defined for the specific path method that
+	// we're iterating over.
+	localParams, err := g.DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+	if err != nil {
+		return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
+			opName, requestPath, err)
+	}
+	// All the parameters required by a handler are the union of the
+	// global parameters and the local parameters.
+	allParams := append(globalParams, localParams...)
+
+	// Order the path parameters to match the order as specified in
+	// the path, not in the swagger spec, and validate that the parameter
+	// names match, as downstream code depends on that.
+	pathParams := FilterParameterDefinitionByType(allParams, "path")
+	pathParams, err = SortParamsByPath(requestPath, pathParams)
+	if err != nil {
+		return nil, err
+	}
 
-			opDef := OperationDefinition{
-				PathParams:   pathParams,
-				HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
-				QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
-				CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
-				OperationId:  toCamelCaseFunc(op.OperationID),
-				// Replace newlines in summary.
-				Summary:         op.Summary,
-				Method:          opName,
-				Path:            requestPath,
-				Spec:            op,
-				Bodies:          bodyDefinitions,
-				Responses:       responseDefinitions,
-				TypeDefinitions: typeDefinitions,
-			}
+	bodyDefinitions, typeDefinitions, err := g.GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error generating body definitions: %w", err)
+	}
 
-			// check for overrides of SecurityDefinitions.
-			// See: "Step 2. Applying security:" from the spec:
-			// https://swagger.io/docs/specification/authentication/
-			if op.Security != nil {
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
-			} else {
-				// use global securityDefinitions
-				// globalSecurityDefinitions contains the top-level securityDefinitions.
-				// They are the default securityPermissions which are injected into each
-				// path, except for the case where a path explicitly overrides them.
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+	responseDefinitions, err := g.GenerateResponseDefinitions(op.OperationID, op.Responses)
+	if err != nil {
+		return nil, fmt.Errorf("error generating response definitions: %w", err)
+	}
 
-			}
+	opDef := OperationDefinition{
+		PathParams:   pathParams,
+		HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
+		QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
+		CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
+		OperationId:  toCamelCaseFunc(op.OperationID),
+		// Replace newlines in summary.
+		Summary:         op.Summary,
+		Method:          opName,
+		Path:            requestPath,
+		Spec:            op,
+		Bodies:          bodyDefinitions,
+		Responses:       responseDefinitions,
+		TypeDefinitions: typeDefinitions,
+	}
 
-			if op.RequestBody != nil {
-				opDef.BodyRequired = op.RequestBody.Value.Required
-			}
+	opDef.Servers, err = operationServerDefinitions(opDef.OperationId, op.Servers)
+	if err != nil {
+		return nil, fmt.Errorf("error describing the servers of %s %s: %w", opName, requestPath, err)
+	}
 
-			// Generate all the type definitions needed for this operation
-			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
+	opDef.Retryable, err = isRetryable(opName, op.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %q in %s %s: %w", extRetryable, opName, requestPath, err)
+	}
 
-			operations = append(operations, opDef)
-		}
+	opDef.Pagination, err = g.describePagination(&opDef)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %q in %s %s: %w", extPagination, opName, requestPath, err)
 	}
-	return operations, nil
+
+	// check for overrides of SecurityDefinitions.
+	// See: "Step 2. Applying security:" from the spec:
+	// https://swagger.io/docs/specification/authentication/
+	if op.Security != nil {
+		opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
+		opDef.SecurityRequirements = DescribeSecurityRequirements(*op.Security)
+	} else {
+		// use global securityDefinitions
+		// globalSecurityDefinitions contains the top-level securityDefinitions.
+		// They are the default securityPermissions which are injected into each
+		// path, except for the case where a path explicitly overrides them.
+		opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+		opDef.SecurityRequirements = DescribeSecurityRequirements(swagger.Security)
+
+	}
+
+	if op.RequestBody != nil {
+		opDef.BodyRequired = op.RequestBody.Value.Required
+	}
+
+	// Generate all the type definitions needed for this operation
+	opDef.TypeDefinitions = append(opDef.TypeDefinitions, g.GenerateTypeDefsForOperation(opDef)...)
+
+	return &opDef, nil
+}
+
+// isRetryable returns whether clients may retry an operation with the given
+// method, which is the case for the idempotent methods of RFC 9110, unless
+// the x-retryable extension of the operation says otherwise.
+func isRetryable(method string, extensions map[string]interface{}) (bool, error) {
+	if value, found := extensions[extRetryable]; found {
+		return extParseRetryable(value)
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true, nil
+	}
+	return false, nil
 }
 
 func generateDefaultOperationID(opName string, requestPath string, toCamelCaseFunc func(string) string) (string, error) {
@@ -643,7 +756,7 @@ func generateDefaultOperationID(opName string, requestPath string, toCamelCaseFu
 
 // GenerateBodyDefinitions turns the Swagger body definitions into a list of our body
 // definitions which will be used for code generation.
-func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+func (g *Generator) GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
 	if bodyOrRef == nil {
 		return nil, nil, nil
 	}
@@ -679,7 +792,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		}
 
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		bodySchema, err := g.GenerateGoSchema(content.Schema, []string{bodyTypeName})
 		if err != nil {
 			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 		}
@@ -687,7 +800,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		// If the body is a pre-defined type
 		if content.Schema != nil && IsGoTypeReference(content.Schema.Ref) {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(content.Schema.Ref)
+			refType, err := g.RefPathToGoType(content.Schema.Ref)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", content.Schema.Ref, err)
 			}
@@ -706,7 +819,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 				}
 
 				// Regenerate the Golang struct adding the new form tag.
-				bodySchema.GoType = GenStructFromSchema(bodySchema)
+				bodySchema.GoType = g.GenStructFromSchema(bodySchema)
 			}
 
 			td := TypeDefinition{
@@ -742,7 +855,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	return bodyDefinitions, typeDefinitions, nil
 }
 
-func GenerateResponseDefinitions(operationID string, responses openapi3.Responses) ([]ResponseDefinition, error) {
+func (g *Generator) GenerateResponseDefinitions(operationID string, responses openapi3.Responses) ([]ResponseDefinition, error) {
 	var responseDefinitions []ResponseDefinition
 	// do not let multiple status codes ref to same response, it will break the type switch
 	refSet := make(map[string]struct{})
@@ -770,6 +883,12 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 				tag = "Multipart"
 			case contentType == "text/plain":
 				tag = "Text"
+			// Streams change the response objects of strict servers, so
+			// they're only read as such when asked to.
+			case contentType == "text/event-stream" && g.options.OutputOptions.StreamingResponses:
+				tag = "EventStream"
+			case contentType == "application/x-ndjson" && g.options.OutputOptions.StreamingResponses:
+				tag = "NDJSON"
 			default:
 				rcd := ResponseContentDefinition{
 					ContentType: contentType,
@@ -779,7 +898,7 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 			}
 
 			responseTypeName := operationID + statusCode + tag + "Response"
-			contentSchema, err := GenerateGoSchema(content.Schema, []string{responseTypeName})
+			contentSchema, err := g.GenerateGoSchema(content.Schema, []string{responseTypeName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating request body definition: %w", err)
 			}
@@ -795,7 +914,7 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 		var responseHeaderDefinitions []ResponseHeaderDefinition
 		for _, headerName := range SortedHeadersKeys(response.Headers) {
 			header := response.Headers[headerName]
-			contentSchema, err := GenerateGoSchema(header.Value.Schema, []string{})
+			contentSchema, err := g.GenerateGoSchema(header.Value.Schema, []string{})
 			if err != nil {
 				return nil, fmt.Errorf("error generating response header definition: %w", err)
 			}
@@ -813,7 +932,7 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 		}
 		if IsGoTypeReference(responseOrRef.Ref) {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(responseOrRef.Ref)
+			refType, err := g.RefPathToGoType(responseOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", responseOrRef.Ref, err)
 			}
@@ -831,11 +950,11 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 	return responseDefinitions, nil
 }
 
-func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
+func (g *Generator) GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 	// Start with the params object itself
 	if len(op.Params()) != 0 {
-		typeDefs = append(typeDefs, GenerateParamsTypes(op)...)
+		typeDefs = append(typeDefs, g.GenerateParamsTypes(op)...)
 	}
 
 	// Now, go through all the additional types we need to declare.
@@ -851,7 +970,7 @@ func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 
 // GenerateParamsTypes defines the schema for a parameters definition object
 // which encapsulates all the query, header and cookie parameters for an operation.
-func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
+func (g *Generator) GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 
 	objectParams := op.QueryParams
@@ -884,7 +1003,7 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 	}
 
 	s.Description = op.Spec.Description
-	s.GoType = GenStructFromSchema(s)
+	s.GoType = g.GenStructFromSchema(s)
 
 	td := TypeDefinition{
 		TypeName: typeName,
@@ -963,24 +1082,79 @@ func GenerateGorillaServer(t *template.Template, operations []OperationDefinitio
 	return GenerateTemplates([]string{"gorilla/gorilla-interface.tmpl", "gorilla/gorilla-middleware.tmpl", "gorilla/gorilla-register.tmpl"}, t, operations)
 }
 
-func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
-
-	var templates []string
-
-	if opts.Generate.ChiServer || opts.Generate.GorillaServer {
-		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-http.tmpl")
+// GenerateStdHTTPServer generates all the go code for the ServerInterface as well as
+// all the wrapper functions around our handlers, which are registered on a
+// net/http ServeMux. The wildcards of ServeMux patterns must be whole path
+// segments, so paths with parameters mixed with other text aren't supported,
+// and must have distinct names, so neither are paths with parameters whose
+// names only differ by characters which wildcard names can't contain.
+func GenerateStdHTTPServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	for _, op := range operations {
+		for _, segment := range strings.Split(op.Path, "/") {
+			if strings.ContainsAny(segment, "{}") && pathParamRE.FindString(segment) != segment {
+				return "", fmt.Errorf("path %s of %s isn't supported by the std-http-server, as parameters must be whole path segments", op.Path, op.OperationId)
+			}
+		}
+		wildcards := map[string]string{}
+		for _, match := range pathParamRE.FindAllStringSubmatch(op.Path, -1) {
+			paramName := match[1]
+			wildcard := StdHttpWildcardName(paramName)
+			if other, found := wildcards[wildcard]; found && other != paramName {
+				return "", fmt.Errorf("path %s of %s isn't supported by the std-http-server, as its parameters %q and %q have the same wildcard name %s", op.Path, op.OperationId, other, paramName, wildcard)
+			}
+			wildcards[wildcard] = paramName
+		}
 	}
-	if opts.Generate.EchoServer {
-		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-echo.tmpl")
+	return GenerateTemplates([]string{"stdhttp/std-http-interface.tmpl", "stdhttp/std-http-middleware.tmpl", "stdhttp/std-http-handler.tmpl"}, t, operations)
+}
+
+func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	servers := []struct {
+		enabled  bool
+		prefix   string
+		template string
+	}{
+		{opts.Generate.ChiServer, "Chi", "strict/strict-http.tmpl"},
+		{opts.Generate.GorillaServer, "Gorilla", "strict/strict-http.tmpl"},
+		{opts.Generate.StdHTTPServer, "StdHTTP", "strict/strict-http.tmpl"},
+		{opts.Generate.EchoServer, "Echo", "strict/strict-echo.tmpl"},
+		{opts.Generate.GinServer, "Gin", "strict/strict-gin.tmpl"},
+		{opts.Generate.FiberServer, "Fiber", "strict/strict-fiber.tmpl"},
 	}
-	if opts.Generate.GinServer {
-		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-gin.tmpl")
+
+	// Several server types share one ServerInterface, with the signature of
+	// net/http handlers, so a single strict handler serves all of them.
+	if opts.Generate.ServerCount() > 1 {
+		servers = servers[:1]
+		servers[0].enabled, servers[0].prefix = true, ""
 	}
-	if opts.Generate.FiberServer {
-		templates = append(templates, "strict/strict-fiber-interface.tmpl", "strict/strict-fiber.tmpl")
+
+	var generated []string
+	for _, server := range servers {
+		if !server.enabled {
+			continue
+		}
+		// The request and response objects are shared between all servers,
+		// so they are only generated once.
+		if len(generated) == 0 {
+			interfaceTemplate := "strict/strict-interface.tmpl"
+			if opts.Generate.FiberServer {
+				interfaceTemplate = "strict/strict-fiber-interface.tmpl"
+			}
+			out, err := GenerateTemplates([]string{interfaceTemplate}, t, operations)
+			if err != nil {
+				return "", err
+			}
+			generated = append(generated, out)
+		}
+		out, err := GenerateTemplates([]string{server.template}, withServerPrefix(t, opts, server.prefix), operations)
+		if err != nil {
+			return "", err
+		}
+		generated = append(generated, out)
 	}
 
-	return GenerateTemplates(templates, t, operations)
+	return strings.Join(generated, "\n"), nil
 }
 
 func GenerateStrictResponses(t *template.Template, responses []ResponseDefinition) (string, error) {
@@ -15,6 +15,7 @@ package codegen
 
 import (
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -146,3 +147,55 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestIsRetryable(t *testing.T) {
+	suite := []struct {
+		method     string
+		extensions map[string]interface{}
+		want       bool
+		wantErr    bool
+	}{
+		{method: http.MethodGet, want: true},
+		{method: http.MethodPut, want: true},
+		{method: http.MethodDelete, want: true},
+		{method: http.MethodPost, want: false},
+		{method: http.MethodPatch, want: false},
+		{method: http.MethodPost, extensions: map[string]interface{}{extRetryable: true}, want: true},
+		{method: http.MethodGet, extensions: map[string]interface{}{extRetryable: false}, want: false},
+		{method: http.MethodGet, extensions: map[string]interface{}{extRetryable: "yes"}, wantErr: true},
+	}
+
+	for _, test := range suite {
+		got, err := isRetryable(test.method, test.extensions)
+		if (err != nil) != test.wantErr {
+			t.Fatalf("isRetryable(%s, %v) error = %v, wantErr %v", test.method, test.extensions, err, test.wantErr)
+		}
+		if got != test.want {
+			t.Errorf("isRetryable(%s, %v) = %v, want %v", test.method, test.extensions, got, test.want)
+		}
+	}
+}
+
+func TestDescribeSecurityRequirements(t *testing.T) {
+	requirements := openapi3.SecurityRequirements{
+		{"oauth": {"admin"}},
+		{"appId": {}, "apiKey": {}},
+		{},
+	}
+
+	got := DescribeSecurityRequirements(requirements)
+	want := [][]SecurityDefinition{
+		{{ProviderName: "oauth", Scopes: []string{"admin"}}},
+		{{ProviderName: "apiKey", Scopes: []string{}}, {ProviderName: "appId", Scopes: []string{}}},
+		{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DescribeSecurityRequirements() = %v, want %v", got, want)
+	}
+	if got := genSecurityRequirements(got); got != `[][]string{{"oauth"}, {"apiKey", "appId"}, {}}` {
+		t.Errorf("genSecurityRequirements() = %s", got)
+	}
+	if got := genSecurityRequirements(nil); got != "nil" {
+		t.Errorf("genSecurityRequirements(nil) = %s", got)
+	}
+}
@@ -0,0 +1,181 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// Strategies of the x-pagination extension, which tell how the next page of a
+// list operation is requested.
+const (
+	// paginationCursor passes the cursor found in the body of a page to a
+	// query parameter.
+	paginationCursor = "cursor"
+	// paginationOffset passes the number of items already fetched to a query
+	// parameter.
+	paginationOffset = "offset"
+	// paginationLink follows the next link of the Link header of a page.
+	paginationLink = "link"
+)
+
+// PaginationDefinition describes how the client follows the pages of a list
+// operation, from its x-pagination extension, such as:
+//
+//	x-pagination:
+//	  strategy: cursor
+//	  items: data
+//	  cursor-param: cursor
+//	  next-cursor: meta.next
+type PaginationDefinition struct {
+	Strategy string // cursor, offset or link
+	// ItemsPath is the path of the items in the JSON body of pages, such as
+	// data, which is empty when the body is the array of items itself.
+	ItemsPath string
+	ItemType  string // The Go type of the items
+
+	CursorParam    *ParameterDefinition // The query parameter of the cursor of the page to fetch
+	NextCursorPath string               // The path of the cursor of the next page in the body of pages
+
+	OffsetParam *ParameterDefinition // The query parameter of the number of items to skip
+	LimitParam  *ParameterDefinition // The query parameter of the number of items of pages, if any
+}
+
+// describePagination describes the pagination of op from its x-pagination
+// extension, returning nil when it has none.
+func (g *Generator) describePagination(op *OperationDefinition) (*PaginationDefinition, error) {
+	value, found := op.Spec.Extensions[extPagination]
+	if !found {
+		return nil, nil
+	}
+	ext, err := extParsePagination(value)
+	if err != nil {
+		return nil, err
+	}
+	if op.HasBody() {
+		return nil, errors.New("operations with request bodies can't be paginated")
+	}
+
+	pagination := &PaginationDefinition{
+		Strategy:  ext["strategy"],
+		ItemsPath: ext["items"],
+	}
+	queryParams := ParameterDefinitions(op.QueryParams)
+	switch pagination.Strategy {
+	case paginationCursor:
+		pagination.NextCursorPath = ext["next-cursor"]
+		if pagination.NextCursorPath == "" {
+			return nil, errors.New("the cursor strategy requires next-cursor")
+		}
+		if pagination.CursorParam = queryParams.FindByName(ext["cursor-param"]); pagination.CursorParam == nil {
+			return nil, fmt.Errorf("cursor-param %q isn't a query parameter", ext["cursor-param"])
+		}
+	case paginationOffset:
+		if pagination.OffsetParam = queryParams.FindByName(ext["offset-param"]); pagination.OffsetParam == nil {
+			return nil, fmt.Errorf("offset-param %q isn't a query parameter", ext["offset-param"])
+		}
+		if name, found := ext["limit-param"]; found {
+			if pagination.LimitParam = queryParams.FindByName(name); pagination.LimitParam == nil {
+				return nil, fmt.Errorf("limit-param %q isn't a query parameter", name)
+			}
+		}
+		for _, param := range []*ParameterDefinition{pagination.OffsetParam, pagination.LimitParam} {
+			if param != nil && (param.Spec.Schema == nil || param.Spec.Schema.Value.Type != "integer") {
+				return nil, fmt.Errorf("parameter %q must be an integer", param.ParamName)
+			}
+		}
+	case paginationLink:
+	default:
+		return nil, fmt.Errorf("unknown strategy %q", pagination.Strategy)
+	}
+
+	items, path, err := paginationItems(op.Spec.Responses, pagination.ItemsPath)
+	if err != nil {
+		return nil, err
+	}
+	if path != nil {
+		// The items are within a schema of the components, whose models
+		// already define the types of the items, named after their path.
+		arraySchema, err := g.GenerateGoSchema(&openapi3.SchemaRef{Value: items.Value}, path)
+		if err != nil {
+			return nil, fmt.Errorf("error generating the type of items: %w", err)
+		}
+		pagination.ItemType = arraySchema.ArrayType.TypeDecl()
+		return pagination, nil
+	}
+	itemSchema, err := g.GenerateGoSchema(items.Value.Items, []string{op.OperationId, "Item"})
+	if err != nil {
+		return nil, fmt.Errorf("error generating the type of items: %w", err)
+	}
+	pagination.ItemType = itemSchema.TypeDecl()
+	// The types the items need, such as those of their inline enums, are
+	// defined along with the other types of the operation.
+	op.TypeDefinitions = append(op.TypeDefinitions, itemSchema.GetAdditionalTypeDefs()...)
+	return pagination, nil
+}
+
+// paginationItems returns the schema of the array of items of the pages of
+// an operation, which is at path in the JSON body of its first successful
+// response. When the array is within a schema of the components, it returns
+// the path the models name the types of the array after as well.
+func paginationItems(responses openapi3.Responses, path string) (*openapi3.SchemaRef, []string, error) {
+	for _, statusCode := range SortedResponsesKeys(responses) {
+		response := responses[statusCode]
+		if !strings.HasPrefix(statusCode, "2") || response == nil || response.Value == nil {
+			continue
+		}
+		for _, contentType := range SortedContentKeys(response.Value.Content) {
+			if !util.IsMediaTypeJson(contentType) {
+				continue
+			}
+			schema := response.Value.Content[contentType].Schema
+			typePath := componentSchemaPath(schema, nil)
+			if path != "" {
+				for _, name := range strings.Split(path, ".") {
+					if schema = findProperty(schema, name); schema == nil {
+						return nil, nil, fmt.Errorf("the body of response %s has no property %s", statusCode, path)
+					}
+					typePath = componentSchemaPath(schema, typePath)
+					if typePath != nil && schema.Ref == "" {
+						typePath = append(typePath, name)
+					}
+				}
+			}
+			if schema == nil || schema.Value == nil || schema.Value.Type != "array" || schema.Value.Items == nil {
+				return nil, nil, fmt.Errorf("the items of response %s aren't an array", statusCode)
+			}
+			return schema, typePath, nil
+		}
+	}
+	return nil, nil, errors.New("there is no successful response with a JSON body")
+}
+
+// componentSchemaPath returns the path of the types of schema, which starts
+// over when it refers to a schema of the components, and is path otherwise.
+func componentSchemaPath(schema *openapi3.SchemaRef, path []string) []string {
+	if schema != nil && strings.HasPrefix(schema.Ref, schemaRefPath("")) {
+		return []string{strings.TrimPrefix(schema.Ref, schemaRefPath(""))}
+	}
+	return path
+}
+
+// findProperty returns the schema of a property of an object, which may be
+// declared by the schemas it's composed of with allOf.
+func findProperty(schema *openapi3.SchemaRef, name string) *openapi3.SchemaRef {
+	if schema == nil || schema.Value == nil {
+		return nil
+	}
+	if property, found := schema.Value.Properties[name]; found {
+		return property
+	}
+	for _, s := range schema.Value.AllOf {
+		if property := findProperty(s, name); property != nil {
+			return property
+		}
+	}
+	return nil
+}
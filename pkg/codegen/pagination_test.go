@@ -0,0 +1,99 @@
+package codegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const paginationSpec = `
+openapi: 3.0.3
+info: {title: pagination, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      x-pagination: %s
+      parameters:
+        - {name: cursor, in: query, schema: {type: string}}
+        - {name: offset, in: query, schema: {type: integer}}
+      responses:
+        '200':
+          description: A page of pets
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  data: {type: array, items: {type: string}}
+                  next: {type: string}
+`
+
+func TestPagination(t *testing.T) {
+	describe := func(pagination string) (*PaginationDefinition, error) {
+		spec, err := openapi3.NewLoader().LoadFromData([]byte(fmt.Sprintf(paginationSpec, pagination)))
+		require.NoError(t, err)
+		g := NewGenerator(Configuration{PackageName: "api"})
+		ops, err := g.OperationDefinitions(spec, false)
+		if err != nil {
+			return nil, err
+		}
+		return ops[0].Pagination, nil
+	}
+
+	pagination, err := describe(`{strategy: cursor, items: data, cursor-param: cursor, next-cursor: next}`)
+	require.NoError(t, err)
+	assert.Equal(t, "string", pagination.ItemType)
+	assert.Equal(t, "Cursor", pagination.CursorParam.GoName())
+	assert.Equal(t, "next", pagination.NextCursorPath)
+
+	_, err = describe(`{strategy: pages}`)
+	assert.ErrorContains(t, err, `unknown strategy "pages"`)
+	_, err = describe(`{strategy: cursor, items: data, cursor-param: page, next-cursor: next}`)
+	assert.ErrorContains(t, err, `cursor-param "page" isn't a query parameter`)
+	_, err = describe(`{strategy: offset, items: data, offset-param: cursor}`)
+	assert.ErrorContains(t, err, `parameter "cursor" must be an integer`)
+	_, err = describe(`{strategy: link, items: next}`)
+	assert.ErrorContains(t, err, "the items of response 200 aren't an array")
+	_, err = describe(`{strategy: link, items: meta.data}`)
+	assert.ErrorContains(t, err, "the body of response 200 has no property meta.data")
+}
+
+func TestPaginationInlineItems(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: pagination, version: 1.0.0}
+paths:
+  /toys:
+    get:
+      operationId: listToys
+      x-pagination: {strategy: link}
+      responses:
+        '200':
+          description: A page of toys
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    kind: {type: string, enum: [ball, rope]}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := NewGenerator(Configuration{PackageName: "api"})
+	ops, err := g.OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+
+	// The types of inline items are defined with those of the operation.
+	assert.Contains(t, ops[0].Pagination.ItemType, "ListToysItemKind")
+	var typeNames []string
+	for _, td := range ops[0].TypeDefinitions {
+		typeNames = append(typeNames, td.TypeName)
+	}
+	assert.Contains(t, typeNames, "ListToysItemKind")
+}
@@ -35,6 +35,17 @@ func walkSwagger(swagger *openapi3.T, doFn func(RefWrapper) (bool, error)) error
 		}
 	}
 
+	// Webhooks reference components like paths do.
+	webhooks, _ := webhookPathItems(swagger)
+	for _, p := range webhooks {
+		for _, param := range p.Parameters {
+			_ = walkParameterRef(param, doFn)
+		}
+		for _, op := range p.Operations() {
+			_ = walkOperation(op, doFn)
+		}
+	}
+
 	_ = walkComponents(swagger.Components, doFn)
 
 	return nil
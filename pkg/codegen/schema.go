@@ -32,7 +32,8 @@ type Schema struct {
 
 	// If this is set, the schema will declare a type via alias, eg,
 	// `type Foo = bool`. If this is not set, we will define this type via
-	// type definition `type Foo bool`
+	// type definition `type Foo bool`. It's never set with the OldAliasing
+	// compatibility option.
 	DefineViaAlias bool
 
 	// The original OpenAPIv3 Schema.
@@ -93,10 +94,17 @@ func (p Property) GoFieldName() string {
 }
 
 func (p Property) GoTypeDef() string {
+	return p.goTypeDef(false)
+}
+
+// goTypeDef returns the type of the property, where required read-only
+// properties are values rather than pointers when requiredReadOnlyAsValue is
+// set, see CompatibilityOptions.DisableRequiredReadOnlyAsPointer.
+func (p Property) goTypeDef(requiredReadOnlyAsValue bool) string {
 	typeDef := p.Schema.TypeDecl()
 	if !p.Schema.SkipOptionalPointer &&
 		(!p.Required || p.Nullable ||
-			(p.ReadOnly && (!p.Required || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)) ||
+			(p.ReadOnly && (!p.Required || !requiredReadOnlyAsValue)) ||
 			p.WriteOnly) {
 
 		typeDef = "*" + typeDef
@@ -136,6 +144,76 @@ func (e *EnumDefinition) GetValues() map[string]string {
 	return newValues
 }
 
+// EnumValue describes a value of an enum, along with the constant defined for
+// it.
+type EnumValue struct {
+	// Name is the name of the constant.
+	Name string
+	// Value is the value, as it appears in the schema.
+	Value string
+	// VarName is the name given to the value by the x-enum-varnames
+	// extension, or the value itself when there's no such name.
+	VarName string
+}
+
+// OrderedValues returns the values of the enum in the order they're listed
+// by the schema, skipping duplicate values.
+func (e *EnumDefinition) OrderedValues() []EnumValue {
+	values := e.GetValues()
+	names := SortedStringKeys(values)
+
+	// Several constants may hold the same value, when they're given different
+	// names, so the first one is used.
+	nameByValue := make(map[string]string, len(values))
+	for _, name := range names {
+		if _, found := nameByValue[values[name]]; !found {
+			nameByValue[values[name]] = name
+		}
+	}
+
+	var schemaValues []string
+	varNames := map[string]string{}
+	if e.Schema.OAPISchema != nil {
+		enumNames, _ := extParseEnumVarNames(e.Schema.OAPISchema.Extensions[extEnumVarNames])
+		for i, v := range e.Schema.OAPISchema.Enum {
+			value := fmt.Sprintf("%v", v)
+			schemaValues = append(schemaValues, value)
+			if _, found := varNames[value]; !found && i < len(enumNames) {
+				varNames[value] = enumNames[i]
+			}
+		}
+	}
+	for _, name := range names {
+		schemaValues = append(schemaValues, values[name])
+	}
+
+	var result []EnumValue
+	for _, value := range schemaValues {
+		name, found := nameByValue[value]
+		if !found {
+			continue
+		}
+		delete(nameByValue, value)
+		varName, found := varNames[value]
+		if !found {
+			varName = value
+		}
+		result = append(result, EnumValue{Name: name, Value: value, VarName: varName})
+	}
+	return result
+}
+
+// HasStringMethod returns whether a String method is generated for the enum,
+// which is the case for integer enums which name their values with the
+// x-enum-varnames extension.
+func (e *EnumDefinition) HasStringMethod() bool {
+	if e.Schema.OAPISchema == nil || e.Schema.OAPISchema.Type != "integer" {
+		return false
+	}
+	_, found := e.Schema.OAPISchema.Extensions[extEnumVarNames]
+	return found
+}
+
 type Constants struct {
 	// SecuritySchemeProviderNames holds all provider names for security schemes.
 	SecuritySchemeProviderNames []string
@@ -178,7 +256,7 @@ type ResponseTypeDefinition struct {
 }
 
 func (t *TypeDefinition) IsAlias() bool {
-	return !globalState.options.Compatibility.OldAliasing && t.Schema.DefineViaAlias
+	return t.Schema.DefineViaAlias
 }
 
 type Discriminator struct {
@@ -218,7 +296,7 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
-func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+func (g *Generator) GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// Add a fallback value in case the sref is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore, we have at least valid Go-Code.
@@ -232,7 +310,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// another type. We're not de-referencing, so simply use the referenced type.
 	if IsGoTypeReference(sref.Ref) {
 		// Convert the reference path to Go type
-		refType, err := RefPathToGoType(sref.Ref)
+		refType, err := g.RefPathToGoType(sref.Ref)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %s",
 				sref.Ref, err)
@@ -240,7 +318,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		return Schema{
 			GoType:         refType,
 			Description:    schema.Description,
-			DefineViaAlias: true,
+			DefineViaAlias: g.aliasing(),
 			OAPISchema:     schema,
 		}, nil
 	}
@@ -255,7 +333,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := g.MergeSchemas(schema.AllOf, path)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error merging schemas: %w", err)
 		}
@@ -271,7 +349,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			return outSchema, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
 		}
 		outSchema.GoType = typeName
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 
 		return outSchema, nil
 	}
@@ -305,7 +383,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				outType = "interface{}"
 			}
 			outSchema.GoType = outType
-			outSchema.DefineViaAlias = true
+			outSchema.DefineViaAlias = g.aliasing()
 		} else {
 			// When we define an object, we want it to be a type definition,
 			// not a type alias, eg, "type Foo struct {...}"
@@ -324,7 +402,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// If additional properties are defined, we will override the default
 			// above with the specific definition.
 			if schema.AdditionalProperties.Schema != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties.Schema, path)
+				additionalSchema, err := g.GenerateGoSchema(schema.AdditionalProperties.Schema, path)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating type for additional properties: %w", err)
 				}
@@ -351,7 +429,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// early-out here and generate a map[string]<schema> instead of an object
 			// that contains this map. We skip over anyOf/oneOf here because they can
 			// introduce properties. allOf was handled above.
-			if !globalState.options.Compatibility.DisableFlattenAdditionalProperties &&
+			if !g.options.Compatibility.DisableFlattenAdditionalProperties &&
 				len(schema.Properties) == 0 && schema.AnyOf == nil && schema.OneOf == nil {
 				// We have a dictionary here. Returns the goType to be just a map from
 				// string to the property type. HasAdditionalProperties=false means
@@ -366,7 +444,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			for _, pName := range SortedSchemaKeys(schema.Properties) {
 				p := schema.Properties[pName]
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := g.GenerateGoSchema(p, propertyPath)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
 				}
@@ -408,17 +486,17 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 
 			if schema.AnyOf != nil {
-				if err := generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for anyOf: %w", err)
 				}
 			}
 			if schema.OneOf != nil {
-				if err := generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for oneOf: %w", err)
 				}
 			}
 
-			outSchema.GoType = GenStructFromSchema(outSchema)
+			outSchema.GoType = g.GenStructFromSchema(outSchema)
 		}
 
 		// Check for x-go-type-name. It behaves much like x-go-type, however, it will
@@ -437,14 +515,14 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			outSchema = Schema{
 				Description:     newTypeDef.Schema.Description,
 				GoType:          typeName,
-				DefineViaAlias:  true,
+				DefineViaAlias:  g.aliasing(),
 				AdditionalTypes: []TypeDefinition{newTypeDef},
 			}
 		}
 
 		return outSchema, nil
 	} else if len(schema.Enum) > 0 {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		// Enums need to be typed, so that the values aren't interchangeable,
 		// so no matter what schema conversion thinks, we need to define a
 		// new type.
@@ -478,7 +556,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			} else {
 				enumName = k
 			}
-			if globalState.options.Compatibility.OldEnumConflicts {
+			if g.options.Compatibility.OldEnumConflicts {
 				outSchema.EnumValues[SchemaNameToTypeName(PathToTypeName(append(path, enumName)))] = v
 			} else {
 				outSchema.EnumValues[SchemaNameToTypeName(k)] = v
@@ -506,7 +584,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			outSchema.RefType = typeName
 		}
 	} else {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
@@ -516,7 +594,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 
 // oapiSchemaToGoType converts an OpenApi schema into a Go type definition for
 // all non-object types.
-func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+func (g *Generator) oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
 	f := schema.Format
 	t := schema.Type
 
@@ -524,7 +602,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 	case "array":
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
-		arrayType, err := GenerateGoSchema(schema.Items, path)
+		arrayType, err := g.GenerateGoSchema(schema.Items, path)
 		if err != nil {
 			return fmt.Errorf("error generating type for array: %w", err)
 		}
@@ -548,7 +626,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		outSchema.GoType = "[]" + arrayType.TypeDecl()
 		outSchema.AdditionalTypes = arrayType.AdditionalTypes
 		outSchema.Properties = arrayType.Properties
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 	case "integer":
 		// We default to int if format doesn't ask for something else.
 		if f == "int64" {
@@ -574,7 +652,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		} else {
 			outSchema.GoType = "int"
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 	case "number":
 		// We default to float for "number"
 		if f == "double" {
@@ -584,13 +662,13 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		} else {
 			return fmt.Errorf("invalid number format: %s", f)
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 	case "boolean":
 		if f != "" {
 			return fmt.Errorf("invalid format (%s) for boolean", f)
 		}
 		outSchema.GoType = "bool"
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 	case "string":
 		// Special case string formats here.
 		switch f {
@@ -613,7 +691,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			// All unrecognized formats are simply a regular string.
 			outSchema.GoType = "string"
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.aliasing()
 	default:
 		return fmt.Errorf("unhandled Schema type: %s", t)
 	}
@@ -637,17 +715,12 @@ type FieldDescriptor struct {
 
 // GenFieldsFromProperties produce corresponding field names with JSON annotations,
 // given a list of schema descriptors
-func GenFieldsFromProperties(props []Property) []string {
+func (g *Generator) GenFieldsFromProperties(props []Property) []string {
 	var fields []string
 	for i, p := range props {
 		field := ""
 
-		goFieldName := p.GoFieldName()
-		if _, ok := p.Extensions[extGoName]; ok {
-			if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
-				goFieldName = extGoFieldName
-			}
-		}
+		goFieldName := structFieldName(p)
 
 		// Add a comment to a field in case we have one, otherwise skip.
 		if p.Description != "" {
@@ -671,19 +744,15 @@ func GenFieldsFromProperties(props []Property) []string {
 			field += fmt.Sprintf("%s\n", DeprecationComment(deprecationReason))
 		}
 
-		// Check x-go-type-skip-optional-pointer, which will override if the type
-		// should be a pointer or not when the field is optional.
-		if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
-			if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
-				p.Schema.SkipOptionalPointer = skipOptionalPointer
-			}
-		}
-
-		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
+		field += fmt.Sprintf("    %s %s", goFieldName, g.structFieldTypeDef(p))
 
 		omitEmpty := !p.Nullable &&
 			(!p.Required || p.ReadOnly || p.WriteOnly) &&
-			(!p.Required || !p.ReadOnly || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)
+			(!p.Required || !p.ReadOnly || !g.options.Compatibility.DisableRequiredReadOnlyAsPointer)
+		if g.isNullableField(p) {
+			// An unspecified Nullable is empty, so that it's omitted.
+			omitEmpty = !p.Required
+		}
 
 		// Support x-omitempty
 		if extOmitEmptyValue, ok := p.Extensions[extPropOmitEmpty]; ok {
@@ -734,6 +803,46 @@ func GenFieldsFromProperties(props []Property) []string {
 	return fields
 }
 
+// structFieldName returns the name of the struct field generated for the
+// property, which may be overridden with x-go-name.
+func structFieldName(p Property) string {
+	if _, ok := p.Extensions[extGoName]; ok {
+		if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
+			return extGoFieldName
+		}
+	}
+	return p.GoFieldName()
+}
+
+// structFieldTypeDef returns the type of the struct field generated for the
+// property.
+func (g *Generator) structFieldTypeDef(p Property) string {
+	if g.isNullableField(p) {
+		return fmt.Sprintf("openapi_types.Nullable[%s]", p.Schema.TypeDecl())
+	}
+
+	// Check x-go-type-skip-optional-pointer, which will override if the type
+	// should be a pointer or not when the field is optional.
+	if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+		if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
+			p.Schema.SkipOptionalPointer = skipOptionalPointer
+		}
+	}
+	return p.goTypeDef(g.options.Compatibility.DisableRequiredReadOnlyAsPointer)
+}
+
+// aliasing returns whether types are defined via aliases where they can be,
+// which they aren't with the OldAliasing compatibility option.
+func (g *Generator) aliasing() bool {
+	return !g.options.Compatibility.OldAliasing
+}
+
+// isNullableField returns whether the struct field generated for the property
+// is a types.Nullable, which tells null from absent values.
+func (g *Generator) isNullableField(p Property) bool {
+	return p.Nullable && g.options.OutputOptions.NullableType
+}
+
 func additionalPropertiesType(schema Schema) string {
 	addPropsType := schema.AdditionalPropertiesType.GoType
 	if schema.AdditionalPropertiesType.RefType != "" {
@@ -745,11 +854,11 @@ func additionalPropertiesType(schema Schema) string {
 	return addPropsType
 }
 
-func GenStructFromSchema(schema Schema) string {
+func (g *Generator) GenStructFromSchema(schema Schema) string {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	// Append all the field definitions
-	objectParts = append(objectParts, GenFieldsFromProperties(schema.Properties)...)
+	objectParts = append(objectParts, g.GenFieldsFromProperties(schema.Properties)...)
 	// Close the struct
 	if schema.HasAdditionalProperties {
 		objectParts = append(objectParts,
@@ -765,14 +874,14 @@ func GenStructFromSchema(schema Schema) string {
 
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
-func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
+func (g *Generator) paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	if param.Content == nil && param.Schema == nil {
 		return Schema{}, fmt.Errorf("parameter '%s' has no schema or content", param.Name)
 	}
 
 	// We can process the schema through the generic schema processor
 	if param.Schema != nil {
-		return GenerateGoSchema(param.Schema, path)
+		return g.GenerateGoSchema(param.Schema, path)
 	}
 
 	// At this point, we have a content type. We know how to deal with
@@ -796,10 +905,10 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	}
 
 	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	return g.GenerateGoSchema(mt.Schema, path)
 }
 
-func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
+func (g *Generator) generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
 	if discriminator != nil {
 		outSchema.Discriminator = &Discriminator{
 			Property: discriminator.PropertyName,
@@ -809,7 +918,7 @@ func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminato
 
 	refToGoTypeMap := make(map[string]string)
 	for i, element := range elements {
-		elementSchema, err := GenerateGoSchema(element, path)
+		elementSchema, err := g.GenerateGoSchema(element, path)
 		if err != nil {
 			return err
 		}
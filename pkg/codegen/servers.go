@@ -0,0 +1,152 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"text/template"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// serverVariableRE matches the variables within the URL of a server, such as
+// {region} in https://{region}.api.example.com.
+var serverVariableRE = regexp.MustCompile(`{([^{}]+)}`)
+
+// ServerDefinition describes a server of the API, for which the client gets
+// its URL, or a function building it from the values of its variables.
+type ServerDefinition struct {
+	Name        string                     // The name of the constant or function, eg, ServerUrlProduction
+	URL         string                     // The URL of the server, which may contain variables, eg, {region}
+	Description string                     // The description of the server
+	Variables   []ServerVariableDefinition // The variables of the URL, in the order they appear in it
+	OperationId string                     // The operation declaring the server, when it overrides the servers of the API
+}
+
+// ServerVariableDefinition describes a variable of the URL of a server, which
+// becomes an argument of the function building the URL.
+type ServerVariableDefinition struct {
+	Name        string        // The name of the variable, as it appears in the URL
+	TypeName    string        // The name of the type of its values, eg, ServerUrlProductionRegion
+	Default     string        // The default value
+	Description string        // The description of the variable
+	Values      []ServerValue // The values of its enum, if it has one
+}
+
+// ServerValue describes a value of the enum of a server variable, along with
+// the constant defined for it.
+type ServerValue struct {
+	Name  string // The name of the constant, eg, ServerUrlProductionRegionEu
+	Value string // The value
+}
+
+// GoVariableName returns the name of the argument for the variable.
+func (v ServerVariableDefinition) GoVariableName() string {
+	name := LowercaseFirstCharacter(SchemaNameToTypeName(v.Name))
+	if IsGoKeyword(name) || IsPredeclaredGoIdentifier(name) {
+		name = "v" + UppercaseFirstCharacter(name)
+	}
+	if unicode.IsNumber([]rune(name)[0]) {
+		name = "n" + name
+	}
+	return name
+}
+
+// ServerDefinitions describes the servers declared at the top level of the
+// spec. Servers are named after their x-go-name extension, or their
+// description, and otherwise after their position.
+func ServerDefinitions(swagger *openapi3.T) ([]ServerDefinition, error) {
+	return serverDefinitions(swagger.Servers, "ServerUrl", "")
+}
+
+// operationServerDefinitions describes the servers declared by an operation,
+// or by its path, which override the servers of the API. They are named like
+// the servers of the API, after the operation, eg, UploadServerUrl.
+func operationServerDefinitions(operationID string, servers *openapi3.Servers) ([]ServerDefinition, error) {
+	if servers == nil {
+		return nil, nil
+	}
+	return serverDefinitions(*servers, operationID+"ServerUrl", operationID)
+}
+
+func serverDefinitions(servers openapi3.Servers, prefix, operationID string) ([]ServerDefinition, error) {
+	var definitions []ServerDefinition
+	names := map[string]bool{}
+	for i, server := range servers {
+		if server == nil {
+			continue
+		}
+
+		name := prefix
+		if extension, ok := server.Extensions[extGoName]; ok {
+			goName, err := extParseGoFieldName(extension)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q of server %s: %w", extGoName, server.URL, err)
+			}
+			name = goName
+		} else if server.Description != "" {
+			name += ToCamelCase(server.Description)
+		} else if len(servers) > 1 {
+			name += strconv.Itoa(i + 1)
+		}
+		if names[name] {
+			name += strconv.Itoa(i + 1)
+		}
+		names[name] = true
+
+		definition := ServerDefinition{
+			Name:        name,
+			URL:         server.URL,
+			Description: server.Description,
+			OperationId: operationID,
+		}
+
+		seen := map[string]bool{}
+		for _, match := range serverVariableRE.FindAllStringSubmatch(server.URL, -1) {
+			variableName := match[1]
+			if seen[variableName] {
+				continue
+			}
+			seen[variableName] = true
+
+			variable, found := server.Variables[variableName]
+			if !found || variable == nil {
+				return nil, fmt.Errorf("variable %q of server %s isn't declared", variableName, server.URL)
+			}
+			variableDefinition := ServerVariableDefinition{
+				Name:        variableName,
+				TypeName:    name + ToCamelCase(variableName),
+				Default:     variable.Default,
+				Description: variable.Description,
+			}
+
+			sanitized := SanitizeEnumNames(variable.Enum, variable.Enum)
+			nameByValue := make(map[string]string, len(sanitized))
+			for valueName, value := range sanitized {
+				nameByValue[value] = valueName
+			}
+			for _, value := range variable.Enum {
+				if valueName, found := nameByValue[value]; found {
+					variableDefinition.Values = append(variableDefinition.Values, ServerValue{
+						Name:  variableDefinition.TypeName + valueName,
+						Value: value,
+					})
+					delete(nameByValue, value)
+				}
+			}
+			definition.Variables = append(definition.Variables, variableDefinition)
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+// GenerateServerURLs generates the URLs of the servers of the API, and of the
+// operations which override them.
+func GenerateServerURLs(t *template.Template, servers []ServerDefinition, ops []OperationDefinition) (string, error) {
+	for _, op := range ops {
+		servers = append(servers, op.Servers...)
+	}
+	return GenerateTemplates([]string{"servers.tmpl"}, t, servers)
+}
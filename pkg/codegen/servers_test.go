@@ -0,0 +1,78 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serversSpec = `
+openapi: 3.0.3
+info: {title: servers, version: 1.0.0}
+servers:
+  - url: https://{region}.example.com/{type}
+    description: production api
+    variables:
+      type: {default: v1}
+      region: {default: eu, enum: [eu, us-east]}
+  - url: https://staging.example.com
+    x-go-name: StagingServer
+  - url: https://test.example.com
+paths: {}
+`
+
+func TestServerDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(serversSpec))
+	require.NoError(t, err)
+
+	servers, err := ServerDefinitions(swagger)
+	require.NoError(t, err)
+	require.Len(t, servers, 3)
+
+	assert.Equal(t, "ServerUrlProductionApi", servers[0].Name)
+	assert.Equal(t, "StagingServer", servers[1].Name)
+	assert.Equal(t, "ServerUrl3", servers[2].Name)
+
+	// Variables are in the order of the URL.
+	variables := servers[0].Variables
+	require.Len(t, variables, 2)
+	assert.Equal(t, "ServerUrlProductionApiRegion", variables[0].TypeName)
+	assert.Equal(t, "eu", variables[0].Default)
+	assert.Equal(t, []ServerValue{
+		{Name: "ServerUrlProductionApiRegionEu", Value: "eu"},
+		{Name: "ServerUrlProductionApiRegionUsEast", Value: "us-east"},
+	}, variables[0].Values)
+	assert.Equal(t, "vType", variables[1].GoVariableName())
+	assert.Empty(t, variables[1].Values)
+
+	swagger.Servers[0].Variables = nil
+	_, err = ServerDefinitions(swagger)
+	assert.ErrorContains(t, err, `variable "region" of server https://{region}.example.com/{type} isn't declared`)
+}
+
+func TestOperationServerDefinitions(t *testing.T) {
+	servers, err := operationServerDefinitions("Upload", nil)
+	require.NoError(t, err)
+	assert.Empty(t, servers)
+
+	servers, err = operationServerDefinitions("Upload", &openapi3.Servers{{
+		URL:       "https://{region}.example.com",
+		Variables: map[string]*openapi3.ServerVariable{"region": {Default: "eu"}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	assert.Equal(t, "UploadServerUrl", servers[0].Name)
+	assert.Equal(t, "Upload", servers[0].OperationId)
+	require.Len(t, servers[0].Variables, 1)
+	assert.Equal(t, "UploadServerUrlRegion", servers[0].Variables[0].TypeName)
+
+	// Relative servers are kept, the client resolves them against its own.
+	servers, err = operationServerDefinitions("Download", &openapi3.Servers{{URL: "/relative"}, {URL: "/other", Description: "mirror"}})
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+	assert.Equal(t, "DownloadServerUrl1", servers[0].Name)
+	assert.Equal(t, "/relative", servers[0].URL)
+	assert.Equal(t, "DownloadServerUrlMirror", servers[1].Name)
+}
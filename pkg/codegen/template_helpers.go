@@ -17,6 +17,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -31,6 +32,8 @@ const (
 	prefixLeastSpecific = "9"
 
 	defaultClientTypeName = "Client"
+
+	defaultResponseTypeSuffix = "Response"
 )
 
 var (
@@ -39,8 +42,6 @@ var (
 	contentTypesYAML    = []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"}
 	contentTypesXML     = []string{echo.MIMEApplicationXML, echo.MIMETextXML, "application/problems+xml"}
 
-	responseTypeSuffix = "Response"
-
 	titleCaser = cases.Title(language.English)
 )
 
@@ -89,12 +90,30 @@ func genParamNames(params []ParameterDefinition) string {
 	return ", " + strings.Join(parts, ", ")
 }
 
+// genSecurityRequirements generates the security schemes of each of the
+// alternative security requirements of an operation, eg:
+// [][]string{{"bearerAuth"}, {"apiKey", "appId"}}
+func genSecurityRequirements(requirements [][]SecurityDefinition) string {
+	if len(requirements) == 0 {
+		return "nil"
+	}
+	parts := make([]string, len(requirements))
+	for i, requirement := range requirements {
+		names := make([]string, len(requirement))
+		for j, sd := range requirement {
+			names[j] = strconv.Quote(sd.ProviderName)
+		}
+		parts[i] = "{" + strings.Join(names, ", ") + "}"
+	}
+	return "[][]string{" + strings.Join(parts, ", ") + "}"
+}
+
 // genResponsePayload generates the payload returned at the end of each client request function
-func genResponsePayload(operationID string) string {
+func (g *Generator) genResponsePayload(operationID string) string {
 	var buffer = bytes.NewBufferString("")
 
 	// Here is where we build up a response:
-	fmt.Fprintf(buffer, "&%s{\n", genResponseTypeName(operationID))
+	fmt.Fprintf(buffer, "&%s{\n", g.genResponseTypeName(operationID))
 	fmt.Fprintf(buffer, "Body: bodyBytes,\n")
 	fmt.Fprintf(buffer, "HTTPResponse: rsp,\n")
 	fmt.Fprintf(buffer, "}")
@@ -103,12 +122,12 @@ func genResponsePayload(operationID string) string {
 }
 
 // genResponseUnmarshal generates unmarshaling steps for structured response payloads
-func genResponseUnmarshal(op *OperationDefinition) string {
+func (g *Generator) genResponseUnmarshal(op *OperationDefinition) string {
 	var handledCaseClauses = make(map[string]string)
 	var unhandledCaseClauses = make(map[string]string)
 
 	// Get the type definitions from the operation:
-	typeDefinitions, err := op.GetResponseTypeDefinitions()
+	typeDefinitions, err := g.GetResponseTypeDefinitions(op)
 	if err != nil {
 		panic(err)
 	}
@@ -238,12 +257,16 @@ func buildUnmarshalCase(typeDefinition ResponseTypeDefinition, caseAction string
 }
 
 // genResponseTypeName creates the name of generated response types (given the operationID):
-func genResponseTypeName(operationID string) string {
-	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), responseTypeSuffix)
+func (g *Generator) genResponseTypeName(operationID string) string {
+	suffix := g.options.OutputOptions.ResponseTypeSuffix
+	if suffix == "" {
+		suffix = defaultResponseTypeSuffix
+	}
+	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), suffix)
 }
 
-func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
-	td, err := op.GetResponseTypeDefinitions()
+func (g *Generator) getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
+	td, err := g.GetResponseTypeDefinitions(op)
 	if err != nil {
 		panic(err)
 	}
@@ -279,27 +302,50 @@ func stripNewLines(s string) string {
 // TemplateFunctions is passed to the template engine, and we can call each
 // function here by keyName from the template code.
 var TemplateFunctions = template.FuncMap{
-	"genParamArgs":               genParamArgs,
-	"genParamTypes":              genParamTypes,
-	"genParamNames":              genParamNames,
-	"genParamFmtString":          ReplacePathParamsWithStr,
-	"swaggerUriToEchoUri":        SwaggerUriToEchoUri,
-	"swaggerUriToFiberUri":       SwaggerUriToFiberUri,
-	"swaggerUriToChiUri":         SwaggerUriToChiUri,
-	"swaggerUriToGinUri":         SwaggerUriToGinUri,
-	"swaggerUriToGorillaUri":     SwaggerUriToGorillaUri,
-	"lcFirst":                    LowercaseFirstCharacter,
-	"ucFirst":                    UppercaseFirstCharacter,
-	"ucFirstWithPkgName":         UppercaseFirstCharacterWithPkgName,
-	"camelCase":                  ToCamelCase,
-	"genResponsePayload":         genResponsePayload,
-	"genResponseTypeName":        genResponseTypeName,
-	"genResponseUnmarshal":       genResponseUnmarshal,
-	"getResponseTypeDefinitions": getResponseTypeDefinitions,
-	"toStringArray":              toStringArray,
-	"lower":                      strings.ToLower,
-	"title":                      titleCaser.String,
-	"stripNewLines":              stripNewLines,
-	"sanitizeGoIdentity":         SanitizeGoIdentity,
-	"toGoComment":                StringWithTypeNameToGoComment,
+	"genParamArgs":            genParamArgs,
+	"genParamTypes":           genParamTypes,
+	"genParamNames":           genParamNames,
+	"genSecurityRequirements": genSecurityRequirements,
+	"genParamFmtString":       ReplacePathParamsWithStr,
+	"swaggerUriToEchoUri":     SwaggerUriToEchoUri,
+	"swaggerUriToFiberUri":    SwaggerUriToFiberUri,
+	"swaggerUriToChiUri":      SwaggerUriToChiUri,
+	"swaggerUriToGinUri":      SwaggerUriToGinUri,
+	"swaggerUriToGorillaUri":  SwaggerUriToGorillaUri,
+	"swaggerUriToStdHttpUri":  SwaggerUriToStdHttpUri,
+	"stdHttpWildcardName":     StdHttpWildcardName,
+	"lcFirst":                 LowercaseFirstCharacter,
+	"ucFirst":                 UppercaseFirstCharacter,
+	"ucFirstWithPkgName":      UppercaseFirstCharacterWithPkgName,
+	"camelCase":               ToCamelCase,
+	"toStringArray":           toStringArray,
+	"lower":                   strings.ToLower,
+	"title":                   titleCaser.String,
+	"stripNewLines":           stripNewLines,
+	"sanitizeGoIdentity":      SanitizeGoIdentity,
+	"toGoComment":             StringWithTypeNameToGoComment,
+	"serverPrefix":            func() string { return "" },
+	"callbackPrefix":          func() string { return "" },
+	"sharedServerInterface":   func() bool { return false },
+}
+
+// templateFunctions returns the functions of the templates of g, which are
+// TemplateFunctions along with those depending on its options, and those of
+// its hooks.
+func (g *Generator) templateFunctions() template.FuncMap {
+	funcs := make(template.FuncMap, len(TemplateFunctions)+5)
+	for name, f := range TemplateFunctions {
+		funcs[name] = f
+	}
+	funcs["opts"] = func() Configuration { return g.options }
+	funcs["genResponsePayload"] = g.genResponsePayload
+	funcs["genResponseTypeName"] = g.genResponseTypeName
+	funcs["genResponseUnmarshal"] = g.genResponseUnmarshal
+	funcs["getResponseTypeDefinitions"] = g.getResponseTypeDefinitions
+	for _, hooks := range g.hooks {
+		for name, f := range hooks.TemplateFunctions {
+			funcs[name] = f
+		}
+	}
+	return funcs
 }
@@ -287,6 +287,18 @@ func SortedParameterKeys(dict map[string]*openapi3.ParameterRef) []string {
 	return keys
 }
 
+// SortedCallbacksKeys returns sorted keys for a CallbackRef dict
+func SortedCallbacksKeys(dict openapi3.Callbacks) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func SortedRequestBodyKeys(dict map[string]*openapi3.RequestBodyRef) []string {
 	keys := make([]string, len(dict))
 	i := 0
@@ -344,12 +356,12 @@ func RefPathToObjName(refPath string) string {
 // Remote components (document.json#/Foo) are supported if they present in --import-mapping
 // URL components (http://deepmap.com/schemas/document.json#/Foo) are supported if they present in --import-mapping
 // Remote and URL also support standard local paths even though the spec doesn't mention them.
-func RefPathToGoType(refPath string) (string, error) {
-	return refPathToGoType(refPath, true)
+func (g *Generator) RefPathToGoType(refPath string) (string, error) {
+	return g.refPathToGoType(refPath, true)
 }
 
 // refPathToGoType returns the Go typename for refPath given its
-func refPathToGoType(refPath string, local bool) (string, error) {
+func (g *Generator) refPathToGoType(refPath string, local bool) (string, error) {
 	if refPath[0] == '#' {
 		pathParts := strings.Split(refPath, "/")
 		depth := len(pathParts)
@@ -363,7 +375,7 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 
 		// Schemas may have been renamed locally, so look up the actual name in
 		// the spec.
-		name, err := findSchemaNameByRefPath(refPath, globalState.spec)
+		name, err := findSchemaNameByRefPath(refPath, g.spec)
 		if err != nil {
 			return "", fmt.Errorf("error finding ref: %s in spec: %v", refPath, err)
 		}
@@ -380,10 +392,10 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 		return "", fmt.Errorf("unsupported reference: %s", refPath)
 	}
 	remoteComponent, flatComponent := pathParts[0], pathParts[1]
-	if goImport, ok := globalState.importMapping[remoteComponent]; !ok {
+	if goImport, ok := g.importMapping[remoteComponent]; !ok {
 		return "", fmt.Errorf("unrecognized external reference '%s'; please provide the known import for this reference using option --import-mapping", remoteComponent)
 	} else {
-		goType, err := refPathToGoType("#"+flatComponent, false)
+		goType, err := g.refPathToGoType("#"+flatComponent, false)
 		if err != nil {
 			return "", err
 		}
@@ -490,6 +502,51 @@ func SwaggerUriToGorillaUri(uri string) string {
 	return pathParamRE.ReplaceAllString(uri, "{$1}")
 }
 
+// SwaggerUriToStdHttpUri converts a swagger style path URI with parameters to
+// a pattern of the net/http ServeMux of Go 1.22. We need to replace all Swagger
+// parameters with "{param}" wildcards, named with StdHttpWildcardName, and to
+// end paths with a trailing slash with "{$}", so that they only match
+// themselves. Valid input parameters are:
+//
+//	{param}
+//	{param*}
+//	{.param}
+//	{.param*}
+//	{;param}
+//	{;param*}
+//	{?param}
+//	{?param*}
+func SwaggerUriToStdHttpUri(uri string) string {
+	uri = pathParamRE.ReplaceAllStringFunc(uri, func(param string) string {
+		return "{" + StdHttpWildcardName(pathParamRE.FindStringSubmatch(param)[1]) + "}"
+	})
+	if strings.HasSuffix(uri, "/") {
+		uri += "{$}"
+	}
+	return uri
+}
+
+// StdHttpWildcardName returns the name of the ServeMux wildcard for a path
+// parameter. Wildcards must be Go identifiers, so any other character is
+// replaced with an underscore.
+func StdHttpWildcardName(paramName string) string {
+	var sb strings.Builder
+	for i, r := range paramName {
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+			sb.WriteRune(r)
+		case unicode.IsDigit(r):
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
+
 // OrderedParamsFromUri returns the argument names, in order, in a given URI string, so for
 // /path/{param1}/{.param2*}/{?param3}, it would return param1, param2, param3
 func OrderedParamsFromUri(uri string) []string {
@@ -852,7 +909,7 @@ func renameRequestBody(requestBodyName string, requestBodyRef *openapi3.RequestB
 // if the schema wasn't found, and it'll only work successfully for schemas
 // defined within the spec that we parsed.
 func findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
-	if spec.Components == nil {
+	if spec == nil || spec.Components == nil {
 		return "", nil
 	}
 	pathElements := strings.Split(refPath, "/")
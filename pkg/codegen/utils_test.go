@@ -134,12 +134,12 @@ func TestSortedRequestBodyKeys(t *testing.T) {
 }
 
 func TestRefPathToGoType(t *testing.T) {
-	old := globalState.importMapping
-	globalState.importMapping = constructImportMapping(map[string]string{
-		"doc.json":                    "externalref0",
-		"http://deepmap.com/doc.json": "externalref1",
+	g := NewGenerator(Configuration{
+		ImportMapping: map[string]string{
+			"doc.json":                    "externalref0",
+			"http://deepmap.com/doc.json": "externalref1",
+		},
 	})
-	defer func() { globalState.importMapping = old }()
 
 	tests := []struct {
 		name   string
@@ -197,7 +197,7 @@ func TestRefPathToGoType(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			goType, err := RefPathToGoType(tc.path)
+			goType, err := g.RefPathToGoType(tc.path)
 			if tc.goType == "" {
 				assert.Error(t, err)
 				return
@@ -297,6 +297,21 @@ func TestSwaggerUriToFiberUri(t *testing.T) {
 	assert.Equal(t, "/path/:arg/foo", SwaggerUriToFiberUri("/path/{?arg*}/foo"))
 }
 
+func TestSwaggerUriToStdHttpUri(t *testing.T) {
+	assert.Equal(t, "/path", SwaggerUriToStdHttpUri("/path"))
+	assert.Equal(t, "/path/{arg}", SwaggerUriToStdHttpUri("/path/{arg}"))
+	assert.Equal(t, "/path/{arg1}/{arg2}/foo", SwaggerUriToStdHttpUri("/path/{arg1}/{arg2}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToStdHttpUri("/path/{.arg*}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToStdHttpUri("/path/{;arg}/foo"))
+
+	// Wildcards are named with identifiers, and trailing slashes only match
+	// themselves
+	assert.Equal(t, "/path/{pet_id}", SwaggerUriToStdHttpUri("/path/{pet-id}"))
+	assert.Equal(t, "/path/{_1st}", SwaggerUriToStdHttpUri("/path/{1st}"))
+	assert.Equal(t, "/path/{$}", SwaggerUriToStdHttpUri("/path/"))
+	assert.Equal(t, "/{$}", SwaggerUriToStdHttpUri("/"))
+}
+
 func TestOrderedParamsFromUri(t *testing.T) {
 	result := OrderedParamsFromUri("/path/{param1}/{.param2}/{;param3*}/foo")
 	assert.EqualValues(t, []string{"param1", "param2", "param3"}, result)
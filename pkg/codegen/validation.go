@@ -0,0 +1,539 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// ValidationDefinition describes the Validate method generated for a type.
+type ValidationDefinition struct {
+	// TypeName is the name of the type which gets the method.
+	TypeName string
+	// Code is the body of the method. It records constraint violations in
+	// errs, a runtime.ValidationErrors.
+	Code string
+}
+
+// GenerateValidation generates a Validate method for each of the given types,
+// as well as the types defined by the operations, which checks values of the
+// type against the constraints of its schema.
+func (g *Generator) GenerateValidation(t *template.Template, types []TypeDefinition, ops []OperationDefinition) (string, error) {
+	types = withOperationTypes(types, ops)
+	vg := validationGenerator{Generator: g, types: typesByName(types)}
+	var validatedTypes []TypeDefinition
+	for _, td := range types {
+		if !td.IsAlias() {
+			validatedTypes = append(validatedTypes, td)
+		}
+	}
+
+	var definitions []ValidationDefinition
+	for _, td := range validatedTypes {
+		code, err := vg.typeValidation(td)
+		if err != nil {
+			return "", fmt.Errorf("error generating validation for %s: %w", td.TypeName, err)
+		}
+		definitions = append(definitions, ValidationDefinition{
+			TypeName: td.TypeName,
+			Code:     strings.Join(code, "\n"),
+		})
+	}
+
+	return GenerateTemplates([]string{"validation.tmpl"}, t, definitions)
+}
+
+// withOperationTypes returns the given types along with the types defined by
+// the operations and their request bodies, without duplicates.
+func withOperationTypes(types []TypeDefinition, ops []OperationDefinition) []TypeDefinition {
+	for _, op := range ops {
+		types = append(types, op.TypeDefinitions...)
+		for _, body := range op.Bodies {
+			if body.IsSupported() {
+				types = append(types, *body.TypeDef(op.OperationId))
+			}
+		}
+	}
+
+	var result []TypeDefinition
+	seen := map[string]bool{}
+	for _, td := range types {
+		if !seen[td.TypeName] {
+			seen[td.TypeName] = true
+			result = append(result, td)
+		}
+	}
+	return result
+}
+
+func typesByName(types []TypeDefinition) map[string]TypeDefinition {
+	result := make(map[string]TypeDefinition, len(types))
+	for _, td := range types {
+		result[td.TypeName] = td
+	}
+	return result
+}
+
+// validationGenerator generates the statements which validate values against
+// the constraints of their schemas.
+type validationGenerator struct {
+	*Generator
+	// types holds all the type definitions of the package, by type name.
+	types map[string]TypeDefinition
+	// depth is the nesting depth of loops, used to name loop variables.
+	depth int
+}
+
+// validationPath builds the Go expression for the JSON pointer of a value.
+// Tokens known at generation time are kept in static, so that they can be
+// emitted as a single string literal.
+type validationPath struct {
+	dynamic string
+	static  string
+}
+
+func (p validationPath) String() string {
+	switch {
+	case p.dynamic == "":
+		return strconv.Quote(p.static)
+	case p.static == "":
+		return p.dynamic
+	default:
+		return p.dynamic + " + " + strconv.Quote(p.static)
+	}
+}
+
+// withToken returns the path of a property of the value at p.
+func (p validationPath) withToken(token string) validationPath {
+	p.static += "/" + strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+	return p
+}
+
+// withExpression returns the path of the element of the value at p whose
+// token is computed at runtime by expr.
+func (p validationPath) withExpression(expr string) validationPath {
+	p.static += "/"
+	return validationPath{dynamic: p.String() + " + " + expr}
+}
+
+// typeValidation generates the body of the Validate method of td.
+func (g *validationGenerator) typeValidation(td TypeDefinition) ([]string, error) {
+	s := td.Schema
+	root := validationPath{}
+
+	// A type defined from another type doesn't get its methods, so convert
+	// the value to the original type to validate it.
+	if name := s.TypeDecl(); name != td.TypeName && !isStructuralGoType(name) {
+		return g.valueValidation(s, fmt.Sprintf("%s(t)", name), root)
+	}
+
+	var code []string
+	if len(s.EnumValues) != 0 && s.OAPISchema != nil {
+		code = append(code, enumValidation(s, "t", root)...)
+	}
+	if len(s.UnionElements) != 0 {
+		unionCode, err := g.unionValidation(s, root)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, unionCode...)
+	}
+
+	expr := "t"
+	if s.GoType == "string" {
+		expr = "string(t)"
+	}
+	valueCode, err := g.valueValidation(s, expr, root)
+	if err != nil {
+		return nil, err
+	}
+	return append(code, valueCode...), nil
+}
+
+// valueValidation generates the statements which validate the value of expr
+// against the schema s.
+func (g *validationGenerator) valueValidation(s Schema, expr string, path validationPath) ([]string, error) {
+	if s.OAPISchema != nil {
+		if _, ok := s.OAPISchema.Extensions[extPropGoType]; ok {
+			// We know nothing about user provided types.
+			return []string{fmt.Sprintf("errs.Merge(%s, runtime.Validate(%s))", path, expr)}, nil
+		}
+	}
+
+	name := s.TypeDecl()
+	if !isStructuralGoType(name) {
+		if td, found := g.types[name]; found {
+			if td.IsAlias() {
+				// Aliases can't have methods, so check the aliased schema in place.
+				return g.valueValidation(td.Schema, expr, path)
+			}
+			return []string{fmt.Sprintf("errs.Merge(%s, %s.Validate())", path, selectorBase(expr))}, nil
+		}
+		// Types from other packages may or may not have been generated with
+		// validation.
+		return []string{fmt.Sprintf("errs.Merge(%s, runtime.Validate(%s))", path, expr)}, nil
+	}
+
+	switch {
+	case strings.HasPrefix(s.GoType, "struct {"):
+		return g.structValidation(s, expr, path)
+	case s.ArrayType != nil:
+		return g.arrayValidation(s, expr, path)
+	case strings.HasPrefix(s.GoType, "map[string]") && s.AdditionalPropertiesType != nil:
+		return g.mapValidation(*s.AdditionalPropertiesType, expr, path)
+	default:
+		return g.primitiveValidation(s, expr, path)
+	}
+}
+
+// structValidation generates the statements which validate the fields of the
+// struct value of expr.
+func (g *validationGenerator) structValidation(s Schema, expr string, path validationPath) ([]string, error) {
+	var code []string
+	for _, p := range s.Properties {
+		if ignore, err := extParseGoJsonIgnore(p.Extensions[extPropGoJsonIgnore]); err == nil && ignore {
+			continue
+		}
+		fieldExpr := selectorBase(expr) + "." + structFieldName(p)
+		fieldPath := path.withToken(p.JsonFieldName)
+
+		if g.isNullableField(p) {
+			_, value := g.loopVariables()
+			fieldCode, err := g.valueValidation(p.Schema, value, fieldPath)
+			g.depth--
+			if err != nil {
+				return nil, fmt.Errorf("error generating validation for property '%s': %w", p.JsonFieldName, err)
+			}
+			if len(fieldCode) != 0 {
+				code = append(code, fmt.Sprintf("if %s, err := %s.Get(); err == nil {", value, fieldExpr))
+				code = append(code, fieldCode...)
+				code = append(code, "}")
+			}
+			continue
+		}
+
+		if strings.HasPrefix(g.structFieldTypeDef(p), "*") {
+			fieldCode, err := g.valueValidation(p.Schema, "*"+fieldExpr, fieldPath)
+			if err != nil {
+				return nil, fmt.Errorf("error generating validation for property '%s': %w", p.JsonFieldName, err)
+			}
+			if len(fieldCode) != 0 {
+				code = append(code, fmt.Sprintf("if %s != nil {", fieldExpr))
+				code = append(code, fieldCode...)
+				code = append(code, "}")
+			}
+			continue
+		}
+
+		fieldCode, err := g.valueValidation(p.Schema, fieldExpr, fieldPath)
+		if err != nil {
+			return nil, fmt.Errorf("error generating validation for property '%s': %w", p.JsonFieldName, err)
+		}
+		// Nil slices and maps are marshaled as null, which a required
+		// property only takes when it's nullable.
+		if p.Required && !p.Nullable && !p.ReadOnly && !p.WriteOnly && isNilable(g.types, p.Schema) {
+			code = append(code,
+				fmt.Sprintf("if %s == nil {", fieldExpr),
+				fmt.Sprintf(`errs.Add(%s, "is required")`, fieldPath))
+			if len(fieldCode) != 0 {
+				code = append(code, "} else {")
+				code = append(code, fieldCode...)
+			}
+			code = append(code, "}")
+			continue
+		}
+		code = append(code, fieldCode...)
+	}
+
+	if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil {
+		mapCode, err := g.mapValidation(*s.AdditionalPropertiesType, selectorBase(expr)+".AdditionalProperties", path)
+		if err != nil {
+			return nil, fmt.Errorf("error generating validation for additional properties: %w", err)
+		}
+		code = append(code, mapCode...)
+	}
+	return code, nil
+}
+
+// arrayValidation generates the statements which validate the slice value of
+// expr and its items.
+func (g *validationGenerator) arrayValidation(s Schema, expr string, path validationPath) ([]string, error) {
+	var code []string
+	if schema := s.OAPISchema; schema != nil {
+		if schema.MinItems != 0 {
+			code = append(code,
+				fmt.Sprintf("if len(%s) < %d {", expr, schema.MinItems),
+				fmt.Sprintf(`errs.Add(%s, "must have at least %d items")`, path, schema.MinItems),
+				"}")
+		}
+		if schema.MaxItems != nil {
+			code = append(code,
+				fmt.Sprintf("if len(%s) > %d {", expr, *schema.MaxItems),
+				fmt.Sprintf(`errs.Add(%s, "must have at most %d items")`, path, *schema.MaxItems),
+				"}")
+		}
+		if schema.UniqueItems {
+			code = append(code,
+				fmt.Sprintf("if !runtime.HasUniqueItems(%s) {", expr),
+				fmt.Sprintf(`errs.Add(%s, "must have unique items")`, path),
+				"}")
+		}
+	}
+
+	index, item := g.loopVariables()
+	itemCode, err := g.valueValidation(*s.ArrayType, item, path.withExpression(fmt.Sprintf("strconv.Itoa(%s)", index)))
+	g.depth--
+	if err != nil {
+		return nil, fmt.Errorf("error generating validation for array items: %w", err)
+	}
+	if len(itemCode) != 0 {
+		code = append(code, fmt.Sprintf("for %s, %s := range %s {", index, item, expr))
+		code = append(code, itemCode...)
+		code = append(code, "}")
+	}
+	return code, nil
+}
+
+// mapValidation generates the statements which validate the values of the
+// map value of expr against valueSchema.
+func (g *validationGenerator) mapValidation(valueSchema Schema, expr string, path validationPath) ([]string, error) {
+	key, value := g.loopVariables()
+	valuePath := path.withExpression(fmt.Sprintf("runtime.JSONPointerToken(%s)", key))
+	nullable := valueSchema.OAPISchema != nil && valueSchema.OAPISchema.Nullable
+
+	valueExpr := value
+	if nullable {
+		valueExpr = "*" + value
+	}
+	valueCode, err := g.valueValidation(valueSchema, valueExpr, valuePath)
+	g.depth--
+	if err != nil {
+		return nil, err
+	}
+	if len(valueCode) == 0 {
+		return nil, nil
+	}
+
+	code := []string{fmt.Sprintf("for %s, %s := range %s {", key, value, expr)}
+	if nullable {
+		code = append(code, fmt.Sprintf("if %s != nil {", value))
+		code = append(code, valueCode...)
+		code = append(code, "}")
+	} else {
+		code = append(code, valueCode...)
+	}
+	return append(code, "}"), nil
+}
+
+// primitiveValidation generates the statements which check the value of expr
+// against the constraints of s on strings and numbers.
+func (g *validationGenerator) primitiveValidation(s Schema, expr string, path validationPath) ([]string, error) {
+	schema := s.OAPISchema
+	if schema == nil {
+		return nil, nil
+	}
+
+	var code []string
+	switch {
+	case s.GoType == "string":
+		if schema.MinLength != 0 {
+			code = append(code,
+				fmt.Sprintf("if utf8.RuneCountInString(%s) < %d {", expr, schema.MinLength),
+				fmt.Sprintf(`errs.Add(%s, "length must be at least %d")`, path, schema.MinLength),
+				"}")
+		}
+		if schema.MaxLength != nil {
+			code = append(code,
+				fmt.Sprintf("if utf8.RuneCountInString(%s) > %d {", expr, *schema.MaxLength),
+				fmt.Sprintf(`errs.Add(%s, "length must be at most %d")`, path, *schema.MaxLength),
+				"}")
+		}
+		// Patterns are ECMA-262 regular expressions, some of which, like
+		// those with lookarounds, Go's RE2 syntax can't express. Those
+		// aren't checked, with a warning, rather than failing the whole
+		// generation.
+		if schema.Pattern != "" {
+			if _, err := regexp.Compile(schema.Pattern); err != nil {
+				g.warnf("the pattern %q isn't validated, since Go can't compile it: %v", schema.Pattern, err)
+			} else {
+				pattern := strconv.Quote(schema.Pattern)
+				code = append(code,
+					fmt.Sprintf("if !runtime.MatchesPattern(%s, %s) {", pattern, expr),
+					fmt.Sprintf(`errs.Add(%s, "must match pattern %%s", %s)`, path, pattern),
+					"}")
+			}
+		}
+	case isNumericGoType(s.GoType):
+		value := fmt.Sprintf("float64(%s)", expr)
+		if schema.Min != nil {
+			operator, message := "<", "must be greater than or equal to"
+			if schema.ExclusiveMin {
+				operator, message = "<=", "must be greater than"
+			}
+			min := formatFloat(*schema.Min)
+			code = append(code,
+				fmt.Sprintf("if %s %s %s {", value, operator, min),
+				fmt.Sprintf(`errs.Add(%s, "%s %s")`, path, message, min),
+				"}")
+		}
+		if schema.Max != nil {
+			operator, message := ">", "must be less than or equal to"
+			if schema.ExclusiveMax {
+				operator, message = ">=", "must be less than"
+			}
+			max := formatFloat(*schema.Max)
+			code = append(code,
+				fmt.Sprintf("if %s %s %s {", value, operator, max),
+				fmt.Sprintf(`errs.Add(%s, "%s %s")`, path, message, max),
+				"}")
+		}
+		if schema.MultipleOf != nil {
+			multipleOf := formatFloat(*schema.MultipleOf)
+			code = append(code,
+				fmt.Sprintf("if !runtime.IsMultipleOf(%s, %s) {", value, multipleOf),
+				fmt.Sprintf(`errs.Add(%s, "must be a multiple of %s")`, path, multipleOf),
+				"}")
+		}
+	}
+	return code, nil
+}
+
+// enumValidation generates the statements which check that the value of expr
+// is one of the values of the enum s.
+func enumValidation(s Schema, expr string, path validationPath) []string {
+	var values []string
+	seen := map[string]bool{}
+	for _, v := range s.OAPISchema.Enum {
+		value := fmt.Sprint(v)
+		if s.GoType == "string" {
+			value = strconv.Quote(value)
+		}
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+
+	message := fmt.Sprintf("must be one of %v", s.OAPISchema.Enum)
+	return []string{
+		fmt.Sprintf("switch %s {", expr),
+		fmt.Sprintf("case %s:", strings.Join(values, ", ")),
+		"default:",
+		fmt.Sprintf(`errs.Add(%s, %s)`, path, strconv.Quote(strings.ReplaceAll(message, "%", "%%"))),
+		"}",
+	}
+}
+
+// unionValidation generates the statements which check that the union value
+// of t matches its schemas. When the union has a discriminator mapping, the
+// value selected by the discriminator is validated. Otherwise, the value has
+// to be valid for at least one of the schemas. That's also the case for oneOf,
+// because required properties can't be checked once a value has been
+// unmarshaled, which would make values match more than one schema.
+func (g *validationGenerator) unionValidation(s Schema, path validationPath) ([]string, error) {
+	if s.Discriminator != nil && len(s.Discriminator.Mapping) != 0 {
+		return []string{
+			"if value, err := t.ValueByDiscriminator(); err != nil {",
+			fmt.Sprintf(`errs.Add(%s, "%%s", err)`, path),
+			"} else {",
+			fmt.Sprintf("errs.Merge(%s, runtime.Validate(value))", path),
+			"}",
+		}, nil
+	}
+
+	code := []string{"{", "matched := 0"}
+	for _, element := range s.UnionElements {
+		// The errors of each element are only used to tell whether it
+		// matches, so they're collected apart from those of the union.
+		elementCode, err := g.valueValidation(Schema{GoType: element.String()}, "value", validationPath{})
+		if err != nil {
+			return nil, fmt.Errorf("error generating validation for union element %s: %w", element, err)
+		}
+		if len(elementCode) == 0 {
+			code = append(code,
+				fmt.Sprintf("if _, err := t.As%s(); err == nil {", element.Method()),
+				"matched++",
+				"}")
+			continue
+		}
+		code = append(code,
+			fmt.Sprintf("if value, err := t.As%s(); err == nil {", element.Method()),
+			"var errs runtime.ValidationErrors")
+		code = append(code, elementCode...)
+		code = append(code,
+			"if len(errs) == 0 {",
+			"matched++",
+			"}",
+			"}")
+	}
+	keyword := "anyOf"
+	if s.OAPISchema != nil && s.OAPISchema.OneOf != nil {
+		keyword = "oneOf"
+	}
+	return append(code,
+		"if matched == 0 {",
+		fmt.Sprintf(`errs.Add(%s, "must match a schema of %s")`, path, keyword),
+		"}",
+		"}"), nil
+}
+
+// loopVariables returns the names of the variables of a new nested loop. The
+// caller has to decrement g.depth when it's done generating the loop body.
+func (g *validationGenerator) loopVariables() (string, string) {
+	g.depth++
+	return fmt.Sprintf("k%d", g.depth), fmt.Sprintf("v%d", g.depth)
+}
+
+// isNilable returns whether the Go type of s has nil as its zero value. types
+// holds the type definitions of the package, by type name.
+func isNilable(types map[string]TypeDefinition, s Schema) bool {
+	goType := s.TypeDecl()
+	if td, found := types[goType]; found {
+		goType = td.Schema.TypeDecl()
+		if td, found := types[goType]; found {
+			goType = td.Schema.GoType
+		}
+	}
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") ||
+		strings.HasPrefix(goType, "*") || goType == "interface{}" || goType == "json.RawMessage"
+}
+
+// isStructuralGoType returns whether goType is spelled out, rather than being
+// the name of a type defined from a schema, either in this package or in an
+// imported one.
+func isStructuralGoType(goType string) bool {
+	if strings.ContainsAny(goType, "[]{ *") {
+		return true
+	}
+	if strings.HasPrefix(goType, "externalRef") {
+		return false
+	}
+	// Other qualified identifiers are well known types, such as time.Time.
+	return strings.Contains(goType, ".") || isPredeclaredGoType(goType)
+}
+
+func isPredeclaredGoType(goType string) bool {
+	switch goType {
+	case "string", "bool", "float32", "float64", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune", "interface{}":
+		return true
+	}
+	return false
+}
+
+func isNumericGoType(goType string) bool {
+	return isPredeclaredGoType(goType) && goType != "string" && goType != "bool" && goType != "interface{}"
+}
+
+// selectorBase returns the expression to use with a selector of the value of
+// expr. Selectors dereference pointers by themselves, so there's no need to
+// dereference them explicitly.
+func selectorBase(expr string) string {
+	return strings.TrimPrefix(expr, "*")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
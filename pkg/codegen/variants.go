@@ -0,0 +1,440 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The suffixes of the type names of the request and response variants of
+// schemas.
+const (
+	requestVariantSuffix  = "Request"
+	responseVariantSuffix = "Response"
+)
+
+// schemaVariant describes the request or response variant of a schema of the
+// components, which omits the properties that aren't sent in that direction:
+// readOnly properties are only sent in responses, and writeOnly properties
+// only in requests.
+type schemaVariant struct {
+	TypeName   string              // The name of the variant type, eg, PetRequest
+	SchemaName string              // The name of the schema it's a variant of, eg, Pet
+	Schema     *openapi3.SchemaRef // The schema of the variant
+}
+
+// withReadWriteVariants returns a copy of spec in which the bodies of the
+// requests and responses of operations, and of the components, refer to the
+// request and response variants of the schemas, which are returned as well.
+// Schemas get variants when they have readOnly or writeOnly properties, or
+// refer to schemas which do. The spec itself is left alone, so that it can be
+// embedded as it is.
+func withReadWriteVariants(spec *openapi3.T, excludeSchemas []string) (*openapi3.T, []schemaVariant, error) {
+	if spec.Components == nil || len(spec.Components.Schemas) == 0 {
+		return spec, nil, nil
+	}
+	schemas := spec.Components.Schemas
+
+	excluded := make(map[string]bool, len(excludeSchemas))
+	for _, name := range excludeSchemas {
+		excluded[name] = true
+	}
+
+	// Schemas referring to schemas with variants need variants too, so this
+	// goes on until no more schemas are found.
+	withVariants := map[string]bool{}
+	for found := true; found; {
+		found = false
+		for _, name := range SortedSchemaKeys(schemas) {
+			ref := schemaRefPath(name)
+			if excluded[name] || withVariants[ref] {
+				continue
+			}
+			if needsVariants(schemas[name], withVariants, map[*openapi3.Schema]bool{}) {
+				withVariants[ref] = true
+				found = true
+			}
+		}
+	}
+	if len(withVariants) == 0 {
+		return spec, nil, nil
+	}
+
+	request := newVariantTransformer(true, withVariants)
+	response := newVariantTransformer(false, withVariants)
+
+	// The variants refer to each other, so their references are set up
+	// before their schemas are.
+	for _, name := range SortedSchemaKeys(schemas) {
+		if !withVariants[schemaRefPath(name)] {
+			continue
+		}
+		goName, err := renameSchema(name, schemas[name])
+		if err != nil {
+			return nil, nil, fmt.Errorf("error making name for components/schemas/%s: %w", name, err)
+		}
+		for _, transformer := range []*variantTransformer{request, response} {
+			typeName := SchemaNameToTypeName(goName + transformer.suffix())
+			if err := checkVariantName(schemas, typeName); err != nil {
+				return nil, nil, fmt.Errorf("error making %s variant of components/schemas/%s: %w", strings.ToLower(transformer.suffix()), name, err)
+			}
+			transformer.refs[schemaRefPath(name)] = &openapi3.SchemaRef{Ref: schemaRefPath(typeName), Value: &openapi3.Schema{}}
+		}
+	}
+
+	var variants []schemaVariant
+	for _, name := range SortedSchemaKeys(schemas) {
+		ref := schemaRefPath(name)
+		if !withVariants[ref] {
+			continue
+		}
+		for _, transformer := range []*variantTransformer{request, response} {
+			transformer.fillVariant(ref, schemas)
+			variant := transformer.refs[ref]
+			// Schemas which are references get variants which are
+			// references to the variants of the schemas they refer to.
+			schema := &openapi3.SchemaRef{Value: variant.Value}
+			if target := schemas[name].Ref; target != "" {
+				schema.Ref = transformer.refs[target].Ref
+			}
+			variants = append(variants, schemaVariant{
+				TypeName:   strings.TrimPrefix(variant.Ref, schemaRefPath("")),
+				SchemaName: name,
+				Schema:     schema,
+			})
+		}
+	}
+
+	result := *spec
+	components := *spec.Components
+	components.RequestBodies = make(openapi3.RequestBodies, len(spec.Components.RequestBodies))
+	for name, body := range spec.Components.RequestBodies {
+		components.RequestBodies[name] = request.requestBodyRef(body)
+	}
+	components.Responses = make(openapi3.Responses, len(spec.Components.Responses))
+	for name, resp := range spec.Components.Responses {
+		components.Responses[name] = response.responseRef(resp)
+	}
+	result.Components = &components
+
+	result.Paths = make(openapi3.Paths, len(spec.Paths))
+	for requestPath, pathItem := range spec.Paths {
+		if pathItem == nil {
+			result.Paths[requestPath] = nil
+			continue
+		}
+		item := *pathItem
+		for method, op := range pathItem.Operations() {
+			operation := *op
+			operation.RequestBody = request.requestBodyRef(op.RequestBody)
+			if op.Responses != nil {
+				operation.Responses = make(openapi3.Responses, len(op.Responses))
+				for statusCode, resp := range op.Responses {
+					operation.Responses[statusCode] = response.responseRef(resp)
+				}
+			}
+			item.SetOperation(method, &operation)
+		}
+		result.Paths[requestPath] = &item
+	}
+	return &result, variants, nil
+}
+
+// schemaRefPath returns the reference to the schema of the components with
+// the given name.
+func schemaRefPath(name string) string {
+	return "#/components/schemas/" + name
+}
+
+// checkVariantName checks that the name of a variant isn't taken by a schema.
+func checkVariantName(schemas openapi3.Schemas, typeName string) error {
+	for name, schema := range schemas {
+		goName, err := renameSchema(name, schema)
+		if err != nil {
+			return err
+		}
+		if name == typeName || goName == typeName {
+			return fmt.Errorf("its name %s is taken by components/schemas/%s, please use x-go-name to rename one of them", typeName, name)
+		}
+	}
+	return nil
+}
+
+// checkVariantOperationNames checks that the names of variants aren't taken
+// by the response types which ClientWithResponses declares for operations,
+// such as CreateUserResponse for createUser, which are named by
+// responseTypeName.
+func checkVariantOperationNames(variants []schemaVariant, ops []OperationDefinition, responseTypeName func(string) string) error {
+	operations := make(map[string]string, len(ops))
+	for _, op := range ops {
+		operations[responseTypeName(op.OperationId)] = op.OperationId
+	}
+	for _, variant := range variants {
+		if operationID, found := operations[variant.TypeName]; found {
+			return fmt.Errorf("the name %s of a variant of components/schemas/%s is taken by the response type of operation %s, please use x-go-name to rename the schema, or response-type-suffix to rename the response types", variant.TypeName, variant.SchemaName, operationID)
+		}
+	}
+	return nil
+}
+
+// needsVariants returns whether the schema has readOnly or writeOnly
+// properties, or refers to schemas which have variants, directly or through
+// its inline schemas.
+func needsVariants(sref *openapi3.SchemaRef, withVariants map[string]bool, visited map[*openapi3.Schema]bool) bool {
+	if sref == nil || sref.Value == nil {
+		return false
+	}
+	if sref.Ref != "" {
+		return withVariants[sref.Ref]
+	}
+	s := sref.Value
+	if visited[s] {
+		return false
+	}
+	visited[s] = true
+
+	// Types from elsewhere are used as they are.
+	if _, found := s.Extensions[extPropGoType]; found {
+		return false
+	}
+
+	for _, p := range s.Properties {
+		if p != nil && p.Value != nil && (p.Value.ReadOnly || p.Value.WriteOnly) {
+			return true
+		}
+		if needsVariants(p, withVariants, visited) {
+			return true
+		}
+	}
+	for _, refs := range []openapi3.SchemaRefs{s.AllOf, s.AnyOf, s.OneOf, {s.Items, s.AdditionalProperties.Schema, s.Not}} {
+		for _, ref := range refs {
+			if needsVariants(ref, withVariants, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// variantTransformer makes the request or response variants of schemas.
+type variantTransformer struct {
+	request bool
+	// withVariants holds the references to the schemas which have variants.
+	withVariants map[string]bool
+	// refs maps the references to schemas which have variants to the
+	// references to their variants.
+	refs map[string]*openapi3.SchemaRef
+	// filled holds the references to the schemas whose variants are set.
+	filled map[string]bool
+}
+
+func newVariantTransformer(request bool, withVariants map[string]bool) *variantTransformer {
+	return &variantTransformer{
+		request:      request,
+		withVariants: withVariants,
+		refs:         map[string]*openapi3.SchemaRef{},
+		filled:       map[string]bool{},
+	}
+}
+
+func (v *variantTransformer) suffix() string {
+	if v.request {
+		return requestVariantSuffix
+	}
+	return responseVariantSuffix
+}
+
+// omits returns whether the variants omit the property with the schema p.
+func (v *variantTransformer) omits(p *openapi3.SchemaRef) bool {
+	if p == nil || p.Value == nil {
+		return false
+	}
+	if v.request {
+		return p.Value.ReadOnly
+	}
+	return p.Value.WriteOnly
+}
+
+// fillVariant sets the schema of the variant of the schema of the components
+// with the reference ref. The variant of a reference is the variant of the
+// schema it refers to.
+func (v *variantTransformer) fillVariant(ref string, schemas openapi3.Schemas) {
+	if v.filled[ref] {
+		return
+	}
+	v.filled[ref] = true
+
+	sref := schemas[strings.TrimPrefix(ref, schemaRefPath(""))]
+	variant := v.refs[ref].Value
+	if sref.Ref != "" {
+		v.fillVariant(sref.Ref, schemas)
+		*variant = *v.refs[sref.Ref].Value
+		return
+	}
+	*variant = *v.schema(sref.Value)
+
+	// The type names given by x-go-type-name are taken by the schema itself.
+	if _, found := variant.Extensions[extGoTypeName]; found {
+		extensions := make(map[string]interface{}, len(variant.Extensions))
+		for name, value := range variant.Extensions {
+			if name != extGoTypeName {
+				extensions[name] = value
+			}
+		}
+		variant.Extensions = extensions
+	}
+}
+
+// schemaRef returns the variant of a schema. Schemas without variants, and
+// schemas which don't contain any, are returned as they are, so that the types
+// generated for them, such as enums, are shared with the models.
+func (v *variantTransformer) schemaRef(sref *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if sref == nil || sref.Value == nil {
+		return sref
+	}
+	if sref.Ref != "" {
+		if variant, found := v.refs[sref.Ref]; found {
+			return variant
+		}
+		return sref
+	}
+	if !needsVariants(sref, v.withVariants, map[*openapi3.Schema]bool{}) {
+		return sref
+	}
+	return &openapi3.SchemaRef{Value: v.schema(sref.Value)}
+}
+
+func (v *variantTransformer) schemaRefs(srefs openapi3.SchemaRefs) openapi3.SchemaRefs {
+	if srefs == nil {
+		return nil
+	}
+	result := make(openapi3.SchemaRefs, len(srefs))
+	for i, sref := range srefs {
+		result[i] = v.schemaRef(sref)
+	}
+	return result
+}
+
+// schema returns a copy of the inline schema s without the properties which
+// the variant omits, and which refers to variants.
+func (v *variantTransformer) schema(s *openapi3.Schema) *openapi3.Schema {
+	result := *s
+
+	if s.Properties != nil {
+		result.Properties = make(openapi3.Schemas, len(s.Properties))
+		for name, p := range s.Properties {
+			if !v.omits(p) {
+				result.Properties[name] = v.schemaRef(p)
+			}
+		}
+		result.Required = nil
+		for _, name := range s.Required {
+			if _, found := s.Properties[name]; !found || result.Properties[name] != nil {
+				result.Required = append(result.Required, name)
+			}
+		}
+	}
+
+	result.Items = v.schemaRef(s.Items)
+	result.AdditionalProperties.Schema = v.schemaRef(s.AdditionalProperties.Schema)
+	result.Not = v.schemaRef(s.Not)
+	result.AllOf = v.schemaRefs(s.AllOf)
+	result.AnyOf = v.schemaRefs(s.AnyOf)
+	result.OneOf = v.schemaRefs(s.OneOf)
+
+	// Union elements which are schemas with variants are mapped to their
+	// variants, under the discriminator values of the schemas.
+	if s.Discriminator != nil {
+		discriminator := *s.Discriminator
+		discriminator.Mapping = make(map[string]string, len(s.Discriminator.Mapping))
+		mapped := map[string]bool{}
+		for value, ref := range s.Discriminator.Mapping {
+			mapped[ref] = true
+			if variant, found := v.refs[ref]; found {
+				ref = variant.Ref
+			}
+			discriminator.Mapping[value] = ref
+		}
+		for _, element := range append(s.OneOf[:len(s.OneOf):len(s.OneOf)], s.AnyOf...) {
+			if variant, found := v.refs[element.Ref]; found && !mapped[element.Ref] {
+				discriminator.Mapping[RefPathToObjName(element.Ref)] = variant.Ref
+			}
+		}
+		result.Discriminator = &discriminator
+	}
+	return &result
+}
+
+// requestBodyRef returns a request body whose content refers to variants.
+func (v *variantTransformer) requestBodyRef(ref *openapi3.RequestBodyRef) *openapi3.RequestBodyRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	body := *ref.Value
+	body.Content = v.content(ref.Value.Content)
+	return &openapi3.RequestBodyRef{Ref: ref.Ref, Value: &body}
+}
+
+// responseRef returns a response whose content refers to variants.
+func (v *variantTransformer) responseRef(ref *openapi3.ResponseRef) *openapi3.ResponseRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	resp := *ref.Value
+	resp.Content = v.content(ref.Value.Content)
+	return &openapi3.ResponseRef{Ref: ref.Ref, Value: &resp}
+}
+
+func (v *variantTransformer) content(content openapi3.Content) openapi3.Content {
+	if content == nil {
+		return nil
+	}
+	result := make(openapi3.Content, len(content))
+	for contentType, mediaType := range content {
+		if mediaType != nil {
+			mt := *mediaType
+			mt.Schema = v.schemaRef(mediaType.Schema)
+			mediaType = &mt
+		}
+		result[contentType] = mediaType
+	}
+	return result
+}
+
+// generateTypesForSchemaVariants generates type definitions for the request
+// and response variants of schemas. Their properties are all sent in their
+// direction, so unlike in the models, required readOnly and writeOnly
+// properties aren't pointers.
+func (g *Generator) generateTypesForSchemaVariants(variants []schemaVariant) ([]TypeDefinition, error) {
+	var types []TypeDefinition
+	for _, variant := range variants {
+		// The types defined for inline schemas, such as enums, are named
+		// after the schema, so that those the variant shares with it are
+		// generated once.
+		goSchema, err := g.GenerateGoSchema(variant.Schema, []string{variant.SchemaName})
+		if err != nil {
+			return nil, fmt.Errorf("error converting variant %s of schema %s to Go type: %w", variant.TypeName, variant.SchemaName, err)
+		}
+
+		var changed bool
+		for i, p := range goSchema.Properties {
+			if p.ReadOnly || p.WriteOnly {
+				goSchema.Properties[i].ReadOnly = false
+				goSchema.Properties[i].WriteOnly = false
+				changed = true
+			}
+		}
+		if changed && strings.HasPrefix(goSchema.GoType, "struct {") {
+			goSchema.GoType = g.GenStructFromSchema(goSchema)
+		}
+
+		types = append(types, TypeDefinition{
+			JsonName: variant.SchemaName,
+			TypeName: variant.TypeName,
+			Schema:   goSchema,
+		})
+		types = append(types, goSchema.GetAdditionalTypeDefs()...)
+	}
+	return types, nil
+}
@@ -0,0 +1,145 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const variantsSpec = `
+openapi: 3.0.3
+info: {title: variants, version: 1.0.0}
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/Pet'}
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Pet'}
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - {$ref: '#/components/schemas/Cat'}
+        - {$ref: '#/components/schemas/Dog'}
+      discriminator:
+        propertyName: kind
+        mapping:
+          doggo: '#/components/schemas/Dog'
+    Cat:
+      type: object
+      x-go-name: Kitty
+      required: [kind, id]
+      properties:
+        kind: {type: string}
+        id: {type: string, readOnly: true}
+    Dog:
+      type: object
+      properties:
+        kind: {type: string}
+        name: {type: string}
+`
+
+func TestWithReadWriteVariants(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(variantsSpec))
+	require.NoError(t, err)
+	g := NewGenerator(Configuration{})
+	g.spec = swagger
+
+	spec, variants, err := withReadWriteVariants(swagger, nil)
+	require.NoError(t, err)
+
+	var names []string
+	for _, variant := range variants {
+		names = append(names, variant.SchemaName+":"+variant.TypeName)
+	}
+	assert.Equal(t, []string{"Cat:KittyRequest", "Cat:KittyResponse", "Pet:PetRequest", "Pet:PetResponse"}, names)
+
+	// The readOnly property is omitted from requests, and no longer required.
+	catRequest := variants[0].Schema.Value
+	assert.NotContains(t, catRequest.Properties, "id")
+	assert.Equal(t, []string{"kind"}, catRequest.Required)
+	assert.Contains(t, variants[1].Schema.Value.Properties, "id")
+
+	// Union elements are mapped to their variants, keeping their
+	// discriminator values.
+	petRequest := variants[2].Schema.Value
+	assert.Equal(t, "#/components/schemas/KittyRequest", petRequest.OneOf[0].Ref)
+	assert.Equal(t, "#/components/schemas/Dog", petRequest.OneOf[1].Ref)
+	assert.Equal(t, map[string]string{
+		"Cat":   "#/components/schemas/KittyRequest",
+		"doggo": "#/components/schemas/Dog",
+	}, petRequest.Discriminator.Mapping)
+
+	// The bodies of the copy refer to the variants, while the spec is left
+	// alone.
+	op := spec.Paths["/pets"].Post
+	assert.Equal(t, "#/components/schemas/PetRequest", op.RequestBody.Value.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/PetResponse", op.Responses["200"].Value.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Pet", swagger.Paths["/pets"].Post.RequestBody.Value.Content["application/json"].Schema.Ref)
+	assert.Contains(t, swagger.Components.Schemas["Cat"].Value.Properties, "id")
+
+	types, err := g.generateTypesForSchemaVariants(variants)
+	require.NoError(t, err)
+	assert.Equal(t, "KittyRequest", types[0].TypeName)
+	assert.Contains(t, types[1].Schema.GoType, "Id string`json:\"id\"`")
+
+	// Excluded schemas, and those referring to them only, get no variants.
+	_, variants, err = withReadWriteVariants(swagger, []string{"Cat"})
+	require.NoError(t, err)
+	assert.Empty(t, variants)
+
+	swagger.Components.Schemas["KittyRequest"] = &openapi3.SchemaRef{Value: &openapi3.Schema{Type: "string"}}
+	_, _, err = withReadWriteVariants(swagger, nil)
+	assert.ErrorContains(t, err, "KittyRequest is taken by components/schemas/KittyRequest")
+}
+
+func TestReadWriteVariantsOperationNames(t *testing.T) {
+	const spec = `
+openapi: 3.0.3
+info: {title: variants, version: 1.0.0}
+paths:
+  /users:
+    post:
+      operationId: createUser
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/CreateUser'}
+      responses:
+        '204': {description: ok}
+components:
+  schemas:
+    CreateUser:
+      type: object
+      properties:
+        id: {type: string, readOnly: true}
+        name: {type: string}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, Client: true},
+		OutputOptions: OutputOptions{
+			ReadWriteVariants: true,
+		},
+	}
+	_, err = Generate(swagger, opts)
+	assert.ErrorContains(t, err, "the name CreateUserResponse of a variant of components/schemas/CreateUser is taken by the response type of operation CreateUser")
+
+	// Other response type names don't collide.
+	opts.OutputOptions.ResponseTypeSuffix = "Result"
+	_, err = Generate(swagger, opts)
+	assert.NoError(t, err)
+}
@@ -1,3 +1,5 @@
+// Package ecdsafile loads and stores ECDSA, Ed25519 and RSA keys in PEM
+// encodings, and as JSON Web Keys.
 package ecdsafile
 
 import (
@@ -53,6 +55,13 @@ func LoadEcdsaPrivateKey(buf []byte) (*ecdsa.PrivateKey, error) {
 	// and we're assuming this encoding contains X509 key material.
 	privateKey, err := x509.ParseECPrivateKey(block.Bytes)
 	if err != nil {
+		// Keys generated with openssl genpkey are PKCS #8 encodings instead.
+		if pkcs8Key, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes); pkcs8Err == nil {
+			if ecdsaKey, ok := pkcs8Key.(*ecdsa.PrivateKey); ok {
+				return ecdsaKey, nil
+			}
+			return nil, errors.New("file contents were not an ECDSA private key")
+		}
 		return nil, fmt.Errorf("Error loading private ECDSA key: %w", err)
 	}
 	return privateKey, nil
@@ -0,0 +1,485 @@
+package ecdsafile
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// errUnsupportedKeyType is returned when unmarshaling a JWK of a type other
+// than EC, OKP or RSA, or with an unsupported curve.
+var errUnsupportedKeyType = errors.New("unsupported JWK key type")
+
+// JWK is a JSON Web Key, as described by RFC 7517, holding an ECDSA, Ed25519
+// or RSA key.
+type JWK struct {
+	// Key is an *ecdsa.PublicKey, an ed25519.PublicKey or an *rsa.PublicKey,
+	// or the private key of one of those types.
+	Key interface{}
+	// KeyID identifies the key within a JWK Set.
+	KeyID string
+	// Algorithm is the JWS algorithm the key is meant for, such as ES256.
+	Algorithm string
+	// Use is the intended use of the key, such as "sig" for signatures.
+	Use string
+}
+
+// NewJWK returns the JWK of an ECDSA, Ed25519 or RSA key for signatures, with
+// the default algorithm of the key: ES256, ES384 or ES512 for ECDSA keys,
+// depending on their curve, EdDSA for Ed25519 keys, and RS256 for RSA keys.
+func NewJWK(key interface{}, keyID string) (JWK, error) {
+	jwk := JWK{Key: key, KeyID: keyID, Use: "sig"}
+	switch publicKey := jwk.PublicKey().(type) {
+	case *ecdsa.PublicKey:
+		crv, err := curveName(publicKey.Curve)
+		if err != nil {
+			return JWK{}, err
+		}
+		jwk.Algorithm = map[string]string{"P-256": "ES256", "P-384": "ES384", "P-521": "ES512"}[crv]
+	case ed25519.PublicKey:
+		jwk.Algorithm = "EdDSA"
+	case *rsa.PublicKey:
+		jwk.Algorithm = "RS256"
+	default:
+		return JWK{}, fmt.Errorf("unsupported key type %T", key)
+	}
+	return jwk, nil
+}
+
+// PublicKey returns the public key of the JWK, or nil when its key isn't
+// supported.
+func (k JWK) PublicKey() crypto.PublicKey {
+	switch key := k.Key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key
+	case *ecdsa.PrivateKey:
+		return &key.PublicKey
+	case ed25519.PrivateKey:
+		return key.Public()
+	case *rsa.PrivateKey:
+		return &key.PublicKey
+	}
+	return nil
+}
+
+// IsPrivate returns whether the JWK holds a private key.
+func (k JWK) IsPrivate() bool {
+	switch k.Key.(type) {
+	case *ecdsa.PrivateKey, ed25519.PrivateKey, *rsa.PrivateKey:
+		return true
+	}
+	return false
+}
+
+// Public returns the JWK with the public key of k, which can be published.
+func (k JWK) Public() JWK {
+	k.Key = k.PublicKey()
+	return k
+}
+
+// Thumbprint returns the base64url encoding of the SHA-256 thumbprint of the
+// public key of the JWK, as described by RFC 7638, which makes a key ID
+// deriving from the key.
+func (k JWK) Thumbprint() (string, error) {
+	fields, err := marshalKey(k.PublicKey())
+	if err != nil {
+		return "", err
+	}
+	// The required members of each key type, in lexicographic order.
+	var canonical string
+	switch fields.Kty {
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, fields.Crv, fields.Kty, fields.X, fields.Y)
+	case "OKP":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, fields.Crv, fields.Kty, fields.X)
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, fields.E, fields.Kty, fields.N)
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// jwkFields are the members of the JSON encoding of a JWK, as described by RFC
+// 7518, section 6, and RFC 8037, section 2.
+type jwkFields struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	D   string `json:"d,omitempty"`
+	P   string `json:"p,omitempty"`
+	Q   string `json:"q,omitempty"`
+	Dp  string `json:"dp,omitempty"`
+	Dq  string `json:"dq,omitempty"`
+	Qi  string `json:"qi,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. Only the public key of the JWK is
+// encoded, so that private keys can't leak by mistake: use MarshalPrivateJSON
+// to encode them.
+func (k JWK) MarshalJSON() ([]byte, error) {
+	return k.marshal(k.Public().Key)
+}
+
+// MarshalPrivateJSON returns the JSON encoding of the JWK, including its
+// private key, if any.
+func (k JWK) MarshalPrivateJSON() ([]byte, error) {
+	return k.marshal(k.Key)
+}
+
+func (k JWK) marshal(key interface{}) ([]byte, error) {
+	if key == nil {
+		// The unsupported key is reported as it is.
+		key = k.Key
+	}
+	fields, err := marshalKey(key)
+	if err != nil {
+		return nil, err
+	}
+	fields.Kid, fields.Use, fields.Alg = k.KeyID, k.Use, k.Algorithm
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *JWK) UnmarshalJSON(data []byte) error {
+	var fields jwkFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	key, err := unmarshalKey(fields)
+	if err != nil {
+		if fields.Kid != "" {
+			return fmt.Errorf("invalid JWK %q: %w", fields.Kid, err)
+		}
+		return fmt.Errorf("invalid JWK: %w", err)
+	}
+	*k = JWK{Key: key, KeyID: fields.Kid, Algorithm: fields.Alg, Use: fields.Use}
+	return nil
+}
+
+// JWKSet is a JWK Set, as described by RFC 7517, section 5, which services
+// publish so that clients can verify their signatures.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Key returns the key of the set with the given ID.
+func (s JWKSet) Key(keyID string) (JWK, bool) {
+	for _, key := range s.Keys {
+		if key.KeyID == keyID {
+			return key, true
+		}
+	}
+	return JWK{}, false
+}
+
+// Public returns the set of the public keys of s, which can be published.
+func (s JWKSet) Public() JWKSet {
+	public := JWKSet{Keys: make([]JWK, len(s.Keys))}
+	for i, key := range s.Keys {
+		public.Keys[i] = key.Public()
+	}
+	return public
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Keys of unsupported types are
+// skipped, as recommended by RFC 7517, section 5.
+func (s *JWKSet) UnmarshalJSON(data []byte) error {
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return err
+	}
+	keys := make([]JWK, 0, len(set.Keys))
+	for _, data := range set.Keys {
+		var key JWK
+		if err := json.Unmarshal(data, &key); err != nil {
+			if errors.Is(err, errUnsupportedKeyType) {
+				continue
+			}
+			return err
+		}
+		keys = append(keys, key)
+	}
+	s.Keys = keys
+	return nil
+}
+
+// LoadJWKSet reads a JWK Set from its JSON encoding.
+func LoadJWKSet(buf []byte) (JWKSet, error) {
+	var set JWKSet
+	if err := json.Unmarshal(buf, &set); err != nil {
+		return JWKSet{}, fmt.Errorf("error loading JWK Set: %w", err)
+	}
+	return set, nil
+}
+
+// StoreJWKSet writes the public keys of a JWK Set to a JSON encoding, which
+// can be published. Use StorePrivateJWKSet to store private keys as well.
+func StoreJWKSet(set JWKSet) ([]byte, error) {
+	if set.Keys == nil {
+		set.Keys = []JWK{}
+	}
+	buf, err := json.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding JWK Set: %w", err)
+	}
+	return append(buf, '\n'), nil
+}
+
+// StorePrivateJWKSet writes a JWK Set to a JSON encoding, including the
+// private keys of the set, which must be kept secret.
+func StorePrivateJWKSet(set JWKSet) ([]byte, error) {
+	keys := make([]json.RawMessage, len(set.Keys))
+	for i, key := range set.Keys {
+		buf, err := key.MarshalPrivateJSON()
+		if err != nil {
+			return nil, fmt.Errorf("error encoding JWK Set: %w", err)
+		}
+		keys[i] = buf
+	}
+	buf, err := json.MarshalIndent(struct {
+		Keys []json.RawMessage `json:"keys"`
+	}{keys}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding JWK Set: %w", err)
+	}
+	return append(buf, '\n'), nil
+}
+
+// marshalKey returns the members of the JWK of a key.
+func marshalKey(key interface{}) (jwkFields, error) {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		crv, err := curveName(key.Curve)
+		if err != nil {
+			return jwkFields{}, err
+		}
+		size := curveSize(key.Curve)
+		return jwkFields{
+			Kty: "EC",
+			Crv: crv,
+			X:   encodeFixed(key.X, size),
+			Y:   encodeFixed(key.Y, size),
+		}, nil
+	case *ecdsa.PrivateKey:
+		fields, err := marshalKey(&key.PublicKey)
+		fields.D = encodeFixed(key.D, curveSize(key.Curve))
+		return fields, err
+	case ed25519.PublicKey:
+		return jwkFields{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(key)}, nil
+	case ed25519.PrivateKey:
+		fields, err := marshalKey(key.Public())
+		fields.D = base64.RawURLEncoding.EncodeToString(key.Seed())
+		return fields, err
+	case *rsa.PublicKey:
+		return jwkFields{Kty: "RSA", N: encode(key.N), E: encode(big.NewInt(int64(key.E)))}, nil
+	case *rsa.PrivateKey:
+		if len(key.Primes) != 2 {
+			return jwkFields{}, errors.New("multi-prime RSA keys aren't supported")
+		}
+		fields, err := marshalKey(&key.PublicKey)
+		p, q := key.Primes[0], key.Primes[1]
+		one := big.NewInt(1)
+		dp := new(big.Int).Mod(key.D, new(big.Int).Sub(p, one))
+		dq := new(big.Int).Mod(key.D, new(big.Int).Sub(q, one))
+		qi := new(big.Int).ModInverse(q, p)
+		fields.D, fields.P, fields.Q = encode(key.D), encode(p), encode(q)
+		fields.Dp, fields.Dq, fields.Qi = encode(dp), encode(dq), encode(qi)
+		return fields, err
+	}
+	return jwkFields{}, fmt.Errorf("unsupported key type %T", key)
+}
+
+// unmarshalKey returns the key of the members of a JWK.
+func unmarshalKey(fields jwkFields) (interface{}, error) {
+	switch fields.Kty {
+	case "EC":
+		curve, ok := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}[fields.Crv]
+		if !ok {
+			return nil, fmt.Errorf("%w: EC curve %q", errUnsupportedKeyType, fields.Crv)
+		}
+		ecdhCurve := map[string]ecdh.Curve{
+			"P-256": ecdh.P256(),
+			"P-384": ecdh.P384(),
+			"P-521": ecdh.P521(),
+		}[fields.Crv]
+		size := curveSize(curve)
+		x, err := decodeFixed("x", fields.X, size)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeFixed("y", fields.Y, size)
+		if err != nil {
+			return nil, err
+		}
+		// crypto/ecdh checks that the point is on the curve, from its
+		// uncompressed encoding, as described by SEC 1, section 2.3.3.
+		point := append([]byte{4}, x.FillBytes(make([]byte, size))...)
+		point = append(point, y.FillBytes(make([]byte, size))...)
+		if _, err := ecdhCurve.NewPublicKey(point); err != nil {
+			return nil, errors.New("point isn't on the curve")
+		}
+		publicKey := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if fields.D == "" {
+			return publicKey, nil
+		}
+		d, err := decodeFixed("d", fields.D, size)
+		if err != nil {
+			return nil, err
+		}
+		ecdhKey, err := ecdhCurve.NewPrivateKey(d.FillBytes(make([]byte, size)))
+		if err != nil {
+			return nil, errors.New("invalid d")
+		}
+		if !bytes.Equal(ecdhKey.PublicKey().Bytes(), point) {
+			return nil, errors.New("private key doesn't match public key")
+		}
+		return &ecdsa.PrivateKey{PublicKey: *publicKey, D: d}, nil
+
+	case "OKP":
+		if fields.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w: OKP curve %q", errUnsupportedKeyType, fields.Crv)
+		}
+		x, err := decodeBytes("x", fields.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid x")
+		}
+		if fields.D == "" {
+			return ed25519.PublicKey(x), nil
+		}
+		seed, err := decodeBytes("d", fields.D)
+		if err != nil {
+			return nil, err
+		}
+		if len(seed) != ed25519.SeedSize {
+			return nil, errors.New("invalid d")
+		}
+		privateKey := ed25519.NewKeyFromSeed(seed)
+		if !privateKey.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(x)) {
+			return nil, errors.New("private key doesn't match public key")
+		}
+		return privateKey, nil
+
+	case "RSA":
+		n, err := decode("n", fields.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode("e", fields.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 2 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid e")
+		}
+		publicKey := &rsa.PublicKey{N: n, E: int(e.Int64())}
+		if fields.D == "" {
+			return publicKey, nil
+		}
+		if fields.P == "" || fields.Q == "" {
+			return nil, errors.New("RSA private keys without their primes aren't supported")
+		}
+		privateKey := &rsa.PrivateKey{PublicKey: *publicKey}
+		if privateKey.D, err = decode("d", fields.D); err != nil {
+			return nil, err
+		}
+		p, err := decode("p", fields.P)
+		if err != nil {
+			return nil, err
+		}
+		q, err := decode("q", fields.Q)
+		if err != nil {
+			return nil, err
+		}
+		privateKey.Primes = []*big.Int{p, q}
+		if err := privateKey.Validate(); err != nil {
+			return nil, err
+		}
+		privateKey.Precompute()
+		return privateKey, nil
+	}
+	return nil, fmt.Errorf("%w %q", errUnsupportedKeyType, fields.Kty)
+}
+
+func curveName(curve elliptic.Curve) (string, error) {
+	switch curve {
+	case elliptic.P256():
+		return "P-256", nil
+	case elliptic.P384():
+		return "P-384", nil
+	case elliptic.P521():
+		return "P-521", nil
+	}
+	return "", fmt.Errorf("unsupported curve %s", curve.Params().Name)
+}
+
+func curveSize(curve elliptic.Curve) int {
+	return (curve.Params().BitSize + 7) / 8
+}
+
+// encode returns the base64url encoding of the big-endian bytes of an
+// integer.
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// encodeFixed is encode, with the bytes padded to size, as required for the
+// coordinates and private keys of ECDSA keys.
+func encodeFixed(i *big.Int, size int) string {
+	return base64.RawURLEncoding.EncodeToString(i.FillBytes(make([]byte, size)))
+}
+
+func decodeBytes(name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("missing %s", name)
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return buf, nil
+}
+
+func decode(name, value string) (*big.Int, error) {
+	buf, err := decodeBytes(name, value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
+
+func decodeFixed(name, value string, size int) (*big.Int, error) {
+	buf, err := decodeBytes(name, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) != size {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
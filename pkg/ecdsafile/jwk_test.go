@@ -0,0 +1,159 @@
+package ecdsafile
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKRoundTrip(t *testing.T) {
+	for name, privateKey := range generateKeys(t) {
+		t.Run(name, func(t *testing.T) {
+			jwk, err := NewJWK(privateKey, name)
+			require.NoError(t, err)
+			assert.True(t, jwk.IsPrivate())
+
+			data, err := jwk.MarshalPrivateJSON()
+			require.NoError(t, err)
+			var loaded JWK
+			require.NoError(t, json.Unmarshal(data, &loaded))
+			assertJWKEqual(t, jwk, loaded)
+
+			public := jwk.Public()
+			assert.False(t, public.IsPrivate())
+			assertKeyEqual(t, privateKey.Public(), public.Key)
+			data, err = json.Marshal(public)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), `"d"`)
+			require.NoError(t, json.Unmarshal(data, &loaded))
+			assertJWKEqual(t, public, loaded)
+
+			// Private keys are only encoded when asked for.
+			data, err = json.Marshal(jwk)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), `"d"`)
+			require.NoError(t, json.Unmarshal(data, &loaded))
+			assertJWKEqual(t, public, loaded)
+		})
+	}
+}
+
+func TestJWKAlgorithms(t *testing.T) {
+	keys := generateKeys(t)
+	for name, alg := range map[string]string{"ecdsa": "ES256", "ed25519": "EdDSA", "rsa": "RS256"} {
+		jwk, err := NewJWK(keys[name], "")
+		require.NoError(t, err)
+		assert.Equal(t, alg, jwk.Algorithm)
+	}
+	_, err := NewJWK("secret", "")
+	assert.EqualError(t, err, "unsupported key type string")
+}
+
+func TestJWKThumbprint(t *testing.T) {
+	// The examples of RFC 7638, section 3.1, and RFC 8037, appendix A.3.
+	var rsaKey, ed25519Key JWK
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"kty": "RSA",
+		"n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		"e": "AQAB",
+		"alg": "RS256",
+		"kid": "2011-04-29"
+	}`), &rsaKey))
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"d": "nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A",
+		"x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+	}`), &ed25519Key))
+	assert.IsType(t, ed25519.PrivateKey{}, ed25519Key.Key)
+
+	thumbprint, err := rsaKey.Thumbprint()
+	require.NoError(t, err)
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+	thumbprint, err = ed25519Key.Thumbprint()
+	require.NoError(t, err)
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", thumbprint)
+}
+
+func TestJWKSet(t *testing.T) {
+	keys := generateKeys(t)
+	var set JWKSet
+	for _, name := range []string{"ecdsa", "ed25519", "rsa"} {
+		jwk, err := NewJWK(keys[name], name)
+		require.NoError(t, err)
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	// Only the public keys are stored, unless asked otherwise.
+	data, err := StoreJWKSet(set)
+	require.NoError(t, err)
+	loaded, err := LoadJWKSet(data)
+	require.NoError(t, err)
+	require.Len(t, loaded.Keys, len(set.Keys))
+	for i, key := range set.Public().Keys {
+		assertJWKEqual(t, key, loaded.Keys[i])
+	}
+	data, err = StorePrivateJWKSet(set)
+	require.NoError(t, err)
+	private, err := LoadJWKSet(data)
+	require.NoError(t, err)
+	require.Len(t, private.Keys, len(set.Keys))
+	for i, key := range set.Keys {
+		assertJWKEqual(t, key, private.Keys[i])
+	}
+
+	key, ok := loaded.Key("ed25519")
+	require.True(t, ok)
+	assertKeyEqual(t, keys["ed25519"].Public(), key.Key)
+	_, ok = loaded.Key("missing")
+	assert.False(t, ok)
+
+	// Keys of unsupported types are skipped.
+	loaded, err = LoadJWKSet([]byte(`{"keys": [
+		{"kty": "oct", "k": "c2VjcmV0"},
+		{"kty": "OKP", "crv": "X25519", "x": "hSDwCYkwp1R0i33ctD73Wg2_Og0mOBr066SpjqqbTmo"},
+		{"kty": "OKP", "crv": "Ed25519", "kid": "ed", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}
+	]}`))
+	require.NoError(t, err)
+	require.Len(t, loaded.Keys, 1)
+	assert.Equal(t, "ed", loaded.Keys[0].KeyID)
+
+	// Invalid keys aren't.
+	_, err = LoadJWKSet([]byte(`{"keys": [{"kty": "EC", "kid": "bad", "crv": "P-256", "x": "AA", "y": "AA"}]}`))
+	assert.EqualError(t, err, `error loading JWK Set: invalid JWK "bad": invalid x`)
+	zero := strings.Repeat("A", 43)
+	_, err = LoadJWKSet([]byte(`{"keys": [{"kty": "EC", "kid": "bad", "crv": "P-256", "x": "` + zero + `", "y": "` + zero + `"}]}`))
+	assert.EqualError(t, err, `error loading JWK Set: invalid JWK "bad": point isn't on the curve`)
+	// The private key of another ECDSA key.
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	var fields map[string]string
+	require.NoError(t, json.Unmarshal(mustMarshalPrivate(t, set.Keys[0]), &fields))
+	fields["d"] = base64.RawURLEncoding.EncodeToString(otherKey.D.FillBytes(make([]byte, 32)))
+	mismatched, err := json.Marshal(fields)
+	require.NoError(t, err)
+	var loadedKey JWK
+	assert.EqualError(t, json.Unmarshal(mismatched, &loadedKey), `invalid JWK "ecdsa": private key doesn't match public key`)
+}
+
+func mustMarshalPrivate(t *testing.T, key JWK) []byte {
+	t.Helper()
+	data, err := key.MarshalPrivateJSON()
+	require.NoError(t, err)
+	return data
+}
+
+func assertJWKEqual(t *testing.T, expected, actual JWK) {
+	t.Helper()
+	assertKeyEqual(t, expected.Key, actual.Key)
+	expected.Key, actual.Key = nil, nil
+	assert.Equal(t, expected, actual)
+}
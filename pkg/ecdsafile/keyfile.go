@@ -0,0 +1,187 @@
+package ecdsafile
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// These are utilities for working with files containing ECDSA, Ed25519 and
+// RSA keys in any of the usual encodings. Keys in the PKCS #8 and PKIX
+// encodings they are stored in can be generated with:
+// 1) Generate an Ed25519 private key
+//    openssl genpkey -algorithm ed25519 -out ed25519privatekey.pem
+// 2) Generate an RSA private key
+//    openssl genpkey -algorithm rsa -pkeyopt rsa_keygen_bits:2048 -out rsaprivatekey.pem
+// 3) Generate the public key from a private key
+//    openssl pkey -in ed25519privatekey.pem -pubout -out ed25519pubkey.pem
+
+// LoadPublicKey reads an ECDSA, Ed25519 or RSA public key from a PKIX
+// encoding, or a PKCS #1 encoding for RSA keys, stored in a PEM encoding. The
+// key is an *ecdsa.PublicKey, an ed25519.PublicKey or an *rsa.PublicKey.
+func LoadPublicKey(buf []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, errors.New("no PEM data block found")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error loading public key: %w", err)
+		}
+		return publicKey, nil
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error loading public key: %w", err)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return publicKey, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+}
+
+// LoadPrivateKey reads an ECDSA, Ed25519 or RSA private key from a PKCS #8
+// encoding, a SEC 1 encoding for ECDSA keys, or a PKCS #1 encoding for RSA
+// keys, stored in a PEM encoding. The key is an *ecdsa.PrivateKey, an
+// ed25519.PrivateKey or an *rsa.PrivateKey.
+func LoadPrivateKey(buf []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, errors.New("no PEM data block found")
+	}
+
+	// The type of the PEM block doesn't tell the encoding reliably, since
+	// StoreEcdsaPrivateKey stores SEC 1 encodings as "PRIVATE KEY", so each
+	// encoding is tried in turn.
+	if privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		switch privateKey := privateKey.(type) {
+		case *ecdsa.PrivateKey:
+			return privateKey, nil
+		case ed25519.PrivateKey:
+			return privateKey, nil
+		case *rsa.PrivateKey:
+			return privateKey, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+	if privateKey, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+	return nil, fmt.Errorf("error loading private key: unsupported encoding of %s", block.Type)
+}
+
+// StorePublicKey writes an ECDSA, Ed25519 or RSA public key to a PKIX encoding
+// stored in a PEM encoding.
+func StorePublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	encodedKey, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error x509 encoding public key: %w", err)
+	}
+	pemEncodedKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: encodedKey,
+	})
+	return pemEncodedKey, nil
+}
+
+// StorePrivateKey writes an ECDSA, Ed25519 or RSA private key to a PKCS #8
+// encoding stored in a PEM encoding.
+func StorePrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	encodedKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error x509 encoding private key: %w", err)
+	}
+	pemEncodedKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: encodedKey,
+	})
+	return pemEncodedKey, nil
+}
+
+// LoadEd25519PublicKey reads an Ed25519 public key from a PKIX encoding stored
+// in a PEM encoding.
+func LoadEd25519PublicKey(buf []byte) (ed25519.PublicKey, error) {
+	publicKey, err := LoadPublicKey(buf)
+	if err != nil {
+		return nil, err
+	}
+	ed25519Key, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("file contents were not an Ed25519 public key")
+	}
+	return ed25519Key, nil
+}
+
+// LoadEd25519PrivateKey reads an Ed25519 private key from a PKCS #8 encoding
+// stored in a PEM encoding.
+func LoadEd25519PrivateKey(buf []byte) (ed25519.PrivateKey, error) {
+	privateKey, err := LoadPrivateKey(buf)
+	if err != nil {
+		return nil, err
+	}
+	ed25519Key, ok := privateKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("file contents were not an Ed25519 private key")
+	}
+	return ed25519Key, nil
+}
+
+// StoreEd25519PublicKey writes an Ed25519 public key to a PEM encoding
+func StoreEd25519PublicKey(publicKey ed25519.PublicKey) ([]byte, error) {
+	return StorePublicKey(publicKey)
+}
+
+// StoreEd25519PrivateKey writes an Ed25519 private key to a PEM encoding
+func StoreEd25519PrivateKey(privateKey ed25519.PrivateKey) ([]byte, error) {
+	return StorePrivateKey(privateKey)
+}
+
+// LoadRsaPublicKey reads an RSA public key from a PKIX or PKCS #1 encoding
+// stored in a PEM encoding.
+func LoadRsaPublicKey(buf []byte) (*rsa.PublicKey, error) {
+	publicKey, err := LoadPublicKey(buf)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("file contents were not an RSA public key")
+	}
+	return rsaKey, nil
+}
+
+// LoadRsaPrivateKey reads an RSA private key from a PKCS #8 or PKCS #1
+// encoding stored in a PEM encoding.
+func LoadRsaPrivateKey(buf []byte) (*rsa.PrivateKey, error) {
+	privateKey, err := LoadPrivateKey(buf)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("file contents were not an RSA private key")
+	}
+	return rsaKey, nil
+}
+
+// StoreRsaPublicKey writes an RSA public key to a PEM encoding
+func StoreRsaPublicKey(publicKey *rsa.PublicKey) ([]byte, error) {
+	return StorePublicKey(publicKey)
+}
+
+// StoreRsaPrivateKey writes an RSA private key to a PEM encoding
+func StoreRsaPrivateKey(privateKey *rsa.PrivateKey) ([]byte, error) {
+	return StorePrivateKey(privateKey)
+}
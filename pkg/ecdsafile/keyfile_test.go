@@ -0,0 +1,123 @@
+package ecdsafile
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateKeys(t *testing.T) map[string]crypto.Signer {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return map[string]crypto.Signer{"ecdsa": ecdsaKey, "ed25519": ed25519Key, "rsa": rsaKey}
+}
+
+type privateKeyEqualer interface {
+	Equal(x crypto.PrivateKey) bool
+}
+
+type publicKeyEqualer interface {
+	Equal(x crypto.PublicKey) bool
+}
+
+// assertKeyEqual compares keys with their Equal method, since the values
+// precomputed for RSA private keys depend on how they were created.
+func assertKeyEqual(t *testing.T, expected, actual interface{}) {
+	t.Helper()
+	switch key := expected.(type) {
+	case privateKeyEqualer:
+		assert.True(t, key.Equal(actual), "expected %T to equal %T", actual, expected)
+	case publicKeyEqualer:
+		assert.True(t, key.Equal(actual), "expected %T to equal %T", actual, expected)
+	default:
+		t.Fatalf("%T has no Equal method", expected)
+	}
+}
+
+func TestStoreLoadKeys(t *testing.T) {
+	for name, privateKey := range generateKeys(t) {
+		t.Run(name, func(t *testing.T) {
+			privatePEM, err := StorePrivateKey(privateKey)
+			require.NoError(t, err)
+			loadedPrivate, err := LoadPrivateKey(privatePEM)
+			require.NoError(t, err)
+			assertKeyEqual(t, privateKey, loadedPrivate)
+
+			publicPEM, err := StorePublicKey(privateKey.Public())
+			require.NoError(t, err)
+			loadedPublic, err := LoadPublicKey(publicPEM)
+			require.NoError(t, err)
+			assertKeyEqual(t, privateKey.Public(), loadedPublic)
+		})
+	}
+}
+
+func TestLoadKeyEncodings(t *testing.T) {
+	keys := generateKeys(t)
+
+	// SEC 1 encodings, as stored by StoreEcdsaPrivateKey.
+	ecdsaKey := keys["ecdsa"].(*ecdsa.PrivateKey)
+	sec1PEM, err := StoreEcdsaPrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	loaded, err := LoadPrivateKey(sec1PEM)
+	require.NoError(t, err)
+	assertKeyEqual(t, ecdsaKey, loaded)
+
+	// PKCS #8 encodings of ECDSA keys, as generated by openssl genpkey.
+	pkcs8PEM, err := StorePrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	loadedEcdsa, err := LoadEcdsaPrivateKey(pkcs8PEM)
+	require.NoError(t, err)
+	assertKeyEqual(t, ecdsaKey, loadedEcdsa)
+
+	// PKCS #1 encodings of RSA keys.
+	rsaKey := keys["rsa"].(*rsa.PrivateKey)
+	loadedRsa, err := LoadRsaPrivateKey(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+	}))
+	require.NoError(t, err)
+	assertKeyEqual(t, rsaKey, loadedRsa)
+	loadedRsaPublic, err := LoadRsaPublicKey(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey),
+	}))
+	require.NoError(t, err)
+	assertKeyEqual(t, &rsaKey.PublicKey, loadedRsaPublic)
+}
+
+func TestLoadKeyTypes(t *testing.T) {
+	keys := generateKeys(t)
+
+	ed25519PEM, err := StoreEd25519PrivateKey(keys["ed25519"].(ed25519.PrivateKey))
+	require.NoError(t, err)
+	_, err = LoadEd25519PrivateKey(ed25519PEM)
+	assert.NoError(t, err)
+	_, err = LoadRsaPrivateKey(ed25519PEM)
+	assert.EqualError(t, err, "file contents were not an RSA private key")
+	_, err = LoadEcdsaPrivateKey(ed25519PEM)
+	assert.EqualError(t, err, "file contents were not an ECDSA private key")
+
+	rsaPEM, err := StoreRsaPublicKey(&keys["rsa"].(*rsa.PrivateKey).PublicKey)
+	require.NoError(t, err)
+	_, err = LoadRsaPublicKey(rsaPEM)
+	assert.NoError(t, err)
+	_, err = LoadEd25519PublicKey(rsaPEM)
+	assert.EqualError(t, err, "file contents were not an Ed25519 public key")
+
+	_, err = LoadPrivateKey([]byte("not a key"))
+	assert.EqualError(t, err, "no PEM data block found")
+}
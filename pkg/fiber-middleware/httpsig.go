@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+// HTTPSignatureVerifier creates a Fiber middleware verifying the HTTP Message
+// Signatures of requests, such as those of
+// securityprovider.SecurityProviderHTTPSignature. Requests whose signature is
+// missing or invalid get an HTTP/401, through errorHandler when it's set.
+// Handlers get the id of the key of the signature with
+// httpsig.KeyIDFromContext on their user context.
+func HTTPSignatureVerifier(verifier *httpsig.Verifier, errorHandler ErrorHandler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		r, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return err
+		}
+		keyID, err := verifier.Verify(r)
+		if err != nil {
+			if errorHandler != nil {
+				errorHandler(c, err.Error(), http.StatusUnauthorized)
+				return nil
+			}
+			return fiber.NewError(http.StatusUnauthorized, err.Error())
+		}
+		c.SetUserContext(httpsig.ContextWithKeyID(c.UserContext(), keyID))
+		return c.Next()
+	}
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+func TestHTTPSignatureVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := httpsig.NewSigner(key, "client-1")
+	require.NoError(t, err)
+	verifier := &httpsig.Verifier{Keys: httpsig.StaticKeys(map[string]crypto.PublicKey{"client-1": &key.PublicKey})}
+
+	app := fiber.New()
+	app.Use(HTTPSignatureVerifier(verifier, nil))
+	app.Post("/resource", func(c *fiber.Ctx) error {
+		keyID, _ := httpsig.KeyIDFromContext(c.UserContext())
+		return c.SendString(keyID)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/resource", strings.NewReader(`{"name":"Fido"}`))
+	require.NoError(t, signer.Sign(req))
+	res, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", string(body))
+
+	res, err = app.Test(httptest.NewRequest(http.MethodPost, "/resource", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	// Errors go through the error handler when it's set.
+	app = fiber.New()
+	app.Use(HTTPSignatureVerifier(verifier, func(c *fiber.Ctx, message string, statusCode int) {
+		_ = c.Status(http.StatusForbidden).SendString("custom: " + message)
+	}))
+	app.Post("/resource", func(c *fiber.Ctx) error {
+		t.Error("handler called for an unsigned request")
+		return nil
+	})
+	res, err = app.Test(httptest.NewRequest(http.MethodPost, "/resource", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	body, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "custom: httpsig: request isn't signed", string(body))
+}
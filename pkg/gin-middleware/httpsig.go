@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+// HTTPSignatureVerifier creates a Gin middleware verifying the HTTP Message
+// Signatures of requests, such as those of
+// securityprovider.SecurityProviderHTTPSignature. Requests whose signature is
+// missing or invalid get an HTTP/401, through errorHandler when it's set.
+// Handlers get the id of the key of the signature with
+// httpsig.KeyIDFromContext.
+func HTTPSignatureVerifier(verifier *httpsig.Verifier, errorHandler ErrorHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyID, err := verifier.Verify(c.Request)
+		if err != nil {
+			if errorHandler != nil {
+				errorHandler(c, err.Error(), http.StatusUnauthorized)
+				// in case the handler didn't internally call Abort, stop the chain
+				c.Abort()
+			} else {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			}
+			return
+		}
+		c.Request = c.Request.WithContext(httpsig.ContextWithKeyID(c.Request.Context(), keyID))
+		c.Next()
+	}
+}
@@ -0,0 +1,481 @@
+// Package httpsig signs and verifies HTTP requests as HTTP Message Signatures,
+// as described by RFC 9421, covering their method, authority, path, query,
+// selected headers and the digest of their body, as described by RFC 9530.
+//
+// Requests are signed by a security provider of the client, such as
+// securityprovider.SecurityProviderHTTPSignature, and verified by the
+// HTTPSignatureVerifier middleware of the server.
+package httpsig
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultLabel is the label of the signatures of a Signer.
+	DefaultLabel = "sig1"
+	// DefaultMaxAge is the maximum age of the signatures accepted by a
+	// Verifier by default.
+	DefaultMaxAge = 5 * time.Minute
+	// DefaultMaxBodySize is the maximum size of the bodies of the requests
+	// accepted by a Verifier by default.
+	DefaultMaxBodySize = 10 << 20
+
+	// maxClockSkew is how far in the future signatures may be created.
+	maxClockSkew = time.Minute
+)
+
+// Components which are always covered by signatures.
+var requiredComponents = []string{"@method", "@authority", "@path", "@query"}
+
+// ErrNoSignature is returned when verifying a request without signature.
+var ErrNoSignature = errors.New("httpsig: request isn't signed")
+
+// Signer signs requests with a private key.
+type Signer struct {
+	key     crypto.Signer
+	alg     string
+	keyID   string
+	headers []string
+	now     func() time.Time
+}
+
+// NewSigner returns a signer of requests with key, which is identified by
+// keyID for verifiers. Signatures cover the method, authority, path and
+// query of requests, the digest of their body, and the given headers when they are
+// present. ECDSA keys on the P-256 and P-384 curves, Ed25519 keys and RSA
+// keys are supported, RSA keys being used with RSASSA-PSS.
+func NewSigner(key crypto.Signer, keyID string, headers ...string) (*Signer, error) {
+	alg, err := algorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{key: key, alg: alg, keyID: keyID, now: time.Now}
+	for _, header := range headers {
+		s.headers = append(s.headers, strings.ToLower(header))
+	}
+	return s, nil
+}
+
+// Sign sets the Signature-Input and Signature headers of req, and the
+// Content-Digest header when it has a body.
+func (s *Signer) Sign(req *http.Request) error {
+	body, err := readBody(req, -1)
+	if err != nil {
+		return err
+	}
+
+	components := append([]string(nil), requiredComponents...)
+	for _, header := range s.headers {
+		if len(req.Header.Values(header)) != 0 {
+			components = append(components, header)
+		}
+	}
+	if len(body) != 0 {
+		req.Header.Set("Content-Digest", "sha-256=:"+base64.StdEncoding.EncodeToString(digest(sha256.New(), body))+":")
+		components = append(components, "content-digest")
+	}
+
+	quoted := make([]string, len(components))
+	for i, component := range components {
+		quoted[i] = strconv.Quote(component)
+	}
+	params := fmt.Sprintf("(%s);created=%d;keyid=%s;alg=%s",
+		strings.Join(quoted, " "), s.now().Unix(), strconv.Quote(s.keyID), strconv.Quote(s.alg))
+
+	base, err := signatureBase(req, components, params)
+	if err != nil {
+		return err
+	}
+	signature, err := sign(s.key, base)
+	if err != nil {
+		return fmt.Errorf("httpsig: error signing request: %w", err)
+	}
+	req.Header.Set("Signature-Input", DefaultLabel+"="+params)
+	req.Header.Set("Signature", DefaultLabel+"=:"+base64.StdEncoding.EncodeToString(signature)+":")
+	return nil
+}
+
+// KeyResolver returns the public key identified by keyID, or an error when
+// there is none.
+type KeyResolver func(keyID string) (crypto.PublicKey, error)
+
+// StaticKeys returns a resolver of the public keys of a map by their id.
+func StaticKeys(keys map[string]crypto.PublicKey) KeyResolver {
+	return func(keyID string) (crypto.PublicKey, error) {
+		key, ok := keys[keyID]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", keyID)
+		}
+		return key, nil
+	}
+}
+
+// Verifier verifies the signatures of requests.
+type Verifier struct {
+	// Keys resolves the public keys of signatures.
+	Keys KeyResolver
+	// MaxAge is the maximum age of signatures, which is DefaultMaxAge when
+	// zero. Signatures of any age are accepted when it's negative.
+	MaxAge time.Duration
+	// RequiredHeaders are the headers which signatures must cover, in
+	// addition to the method, authority, path, query and body digest of
+	// requests.
+	RequiredHeaders []string
+	// MaxBodySize is the maximum size of the bodies of requests, which are
+	// read in memory to verify their digest. It is DefaultMaxBodySize when
+	// zero, and bodies of any size are accepted when it's negative.
+	MaxBodySize int64
+
+	now func() time.Time
+}
+
+// Verify verifies the signature of req, and the digest of its body, returning
+// the id of the key it was signed with. The body of req is restored after
+// being read, and is an error when larger than MaxBodySize.
+func (v *Verifier) Verify(req *http.Request) (string, error) {
+	label, input, err := firstMember(req.Header.Get("Signature-Input"))
+	if err != nil {
+		return "", err
+	}
+	signatures, err := parseDictionary(req.Header.Get("Signature"))
+	if err != nil {
+		return "", err
+	}
+	encoded, ok := signatures[label]
+	if !ok || !strings.HasPrefix(encoded, ":") || !strings.HasSuffix(encoded, ":") || len(encoded) < 2 {
+		return "", fmt.Errorf("httpsig: no signature %s", label)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encoded[1 : len(encoded)-1])
+	if err != nil {
+		return "", fmt.Errorf("httpsig: invalid signature %s: %w", label, err)
+	}
+
+	components, params, err := parseSignatureParams(input)
+	if err != nil {
+		return "", err
+	}
+	covered := make(map[string]bool, len(components))
+	for _, component := range components {
+		covered[component] = true
+	}
+	for _, component := range requiredComponents {
+		if !covered[component] {
+			return "", fmt.Errorf("httpsig: signature doesn't cover %s", component)
+		}
+	}
+	for _, header := range v.RequiredHeaders {
+		if !covered[strings.ToLower(header)] {
+			return "", fmt.Errorf("httpsig: signature doesn't cover %s", strings.ToLower(header))
+		}
+	}
+
+	if err := v.verifyTime(params); err != nil {
+		return "", err
+	}
+	maxBodySize := v.MaxBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	body, err := readBody(req, maxBodySize)
+	if err != nil {
+		return "", err
+	}
+	if len(body) != 0 || req.Header.Get("Content-Digest") != "" {
+		if !covered["content-digest"] {
+			return "", errors.New("httpsig: signature doesn't cover content-digest")
+		}
+		if err := verifyDigest(req.Header.Get("Content-Digest"), body); err != nil {
+			return "", err
+		}
+	}
+
+	keyID := params["keyid"]
+	if v.Keys == nil {
+		return "", errors.New("httpsig: no key resolver")
+	}
+	key, err := v.Keys(keyID)
+	if err != nil {
+		return "", fmt.Errorf("httpsig: %w", err)
+	}
+	alg, err := algorithm(key)
+	if err != nil {
+		return "", err
+	}
+	if params["alg"] != "" && params["alg"] != alg {
+		return "", fmt.Errorf("httpsig: algorithm %s doesn't match key %q", params["alg"], keyID)
+	}
+	base, err := signatureBase(req, components, input)
+	if err != nil {
+		return "", err
+	}
+	if !verify(key, base, signature) {
+		return "", errors.New("httpsig: invalid signature")
+	}
+	return keyID, nil
+}
+
+// verifyTime checks that a signature isn't expired, nor created in the
+// future.
+func (v *Verifier) verifyTime(params map[string]string) error {
+	now := time.Now()
+	if v.now != nil {
+		now = v.now()
+	}
+	if expires, ok := params["expires"]; ok {
+		seconds, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return fmt.Errorf("httpsig: invalid expires %q", expires)
+		}
+		if now.After(time.Unix(seconds, 0)) {
+			return errors.New("httpsig: signature expired")
+		}
+	}
+	if v.MaxAge < 0 {
+		return nil
+	}
+	created, ok := params["created"]
+	if !ok {
+		return errors.New("httpsig: signature has no creation time")
+	}
+	seconds, err := strconv.ParseInt(created, 10, 64)
+	if err != nil {
+		return fmt.Errorf("httpsig: invalid created %q", created)
+	}
+	maxAge := v.MaxAge
+	if maxAge == 0 {
+		maxAge = DefaultMaxAge
+	}
+	createdAt := time.Unix(seconds, 0)
+	if now.Sub(createdAt) > maxAge {
+		return errors.New("httpsig: signature expired")
+	}
+	if createdAt.Sub(now) > maxClockSkew {
+		return errors.New("httpsig: signature created in the future")
+	}
+	return nil
+}
+
+type keyIDContextKey struct{}
+
+// ContextWithKeyID returns a context carrying the id of the key a request was
+// signed with, which verification middlewares pass on to handlers.
+func ContextWithKeyID(ctx context.Context, keyID string) context.Context {
+	return context.WithValue(ctx, keyIDContextKey{}, keyID)
+}
+
+// KeyIDFromContext returns the id of the key the request of ctx was signed
+// with, if it was verified.
+func KeyIDFromContext(ctx context.Context) (string, bool) {
+	keyID, ok := ctx.Value(keyIDContextKey{}).(string)
+	return keyID, ok
+}
+
+// signatureBase returns the signature base of a request, as described by RFC
+// 9421, section 2.5.
+func signatureBase(req *http.Request, components []string, params string) ([]byte, error) {
+	var base bytes.Buffer
+	for _, component := range components {
+		var value string
+		switch component {
+		case "@method":
+			value = req.Method
+		case "@authority":
+			value = authority(req)
+		case "@path":
+			value = req.URL.EscapedPath()
+			if value == "" {
+				value = "/"
+			}
+		case "@query":
+			value = "?" + req.URL.RawQuery
+		default:
+			if strings.HasPrefix(component, "@") {
+				return nil, fmt.Errorf("httpsig: unsupported component %s", component)
+			}
+			values := append([]string(nil), req.Header.Values(component)...)
+			if len(values) == 0 {
+				return nil, fmt.Errorf("httpsig: missing header %s", component)
+			}
+			for i := range values {
+				values[i] = strings.TrimSpace(values[i])
+			}
+			value = strings.Join(values, ", ")
+		}
+		fmt.Fprintf(&base, "%q: %s\n", component, value)
+	}
+	fmt.Fprintf(&base, "\"@signature-params\": %s", params)
+	return base.Bytes(), nil
+}
+
+// authority returns the authority of the target of a request, as described
+// by RFC 9421, section 2.2.3: its host in lower case, and its port unless it's
+// the default one of its scheme. The Host of requests takes precedence over
+// the host of their URL, which servers leave empty.
+func authority(req *http.Request) string {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	host = strings.ToLower(host)
+	scheme := req.URL.Scheme
+	if scheme == "" {
+		scheme = "http"
+		if req.TLS != nil {
+			scheme = "https"
+		}
+	}
+	if defaultPort := map[string]string{"http": ":80", "https": ":443"}[strings.ToLower(scheme)]; defaultPort != "" {
+		host = strings.TrimSuffix(host, defaultPort)
+	}
+	return host
+}
+
+// readBody reads the body of req, and restores it. Bodies larger than
+// maxSize bytes are an error, unless maxSize is negative.
+func readBody(req *http.Request, maxSize int64) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	r := req.Body
+	if maxSize >= 0 {
+		r = http.MaxBytesReader(nil, req.Body, maxSize)
+	}
+	body, err := io.ReadAll(r)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("httpsig: can't read body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
+
+// verifyDigest verifies the Content-Digest header of a body, which must have
+// a sha-256 or sha-512 digest.
+func verifyDigest(header string, body []byte) error {
+	digests, err := parseDictionary(header)
+	if err != nil {
+		return err
+	}
+	for name, h := range map[string]func() hash.Hash{"sha-256": sha256.New, "sha-512": sha512.New} {
+		value, ok := digests[name]
+		if !ok {
+			continue
+		}
+		if !strings.HasPrefix(value, ":") || !strings.HasSuffix(value, ":") || len(value) < 2 {
+			return fmt.Errorf("httpsig: invalid %s digest", name)
+		}
+		want, err := base64.StdEncoding.DecodeString(value[1 : len(value)-1])
+		if err != nil {
+			return fmt.Errorf("httpsig: invalid %s digest: %w", name, err)
+		}
+		if subtle.ConstantTimeCompare(want, digest(h(), body)) != 1 {
+			return errors.New("httpsig: body doesn't match its digest")
+		}
+		return nil
+	}
+	return errors.New("httpsig: no sha-256 or sha-512 digest of the body")
+}
+
+func digest(h hash.Hash, data []byte) []byte {
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// rsaPSSOptions are the options of RSASSA-PSS signatures, as described by RFC
+// 9421, section 3.3.1.
+var rsaPSSOptions = &rsa.PSSOptions{SaltLength: 64, Hash: crypto.SHA512}
+
+// algorithm returns the signature algorithm of a public key.
+func algorithm(key crypto.PublicKey) (string, error) {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return "ecdsa-p256-sha256", nil
+		case elliptic.P384():
+			return "ecdsa-p384-sha384", nil
+		}
+	case ed25519.PublicKey:
+		return "ed25519", nil
+	case *rsa.PublicKey:
+		return "rsa-pss-sha512", nil
+	}
+	return "", fmt.Errorf("httpsig: unsupported key type %T", key)
+}
+
+// sign signs a signature base with key.
+func sign(key crypto.Signer, base []byte) ([]byte, error) {
+	switch key := key.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, base), nil
+	case *rsa.PrivateKey:
+		return rsa.SignPSS(rand.Reader, key, crypto.SHA512, digest(sha512.New(), base), rsaPSSOptions)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, ecKey, ecdsaDigest(&ecKey.PublicKey, base))
+	if err != nil {
+		return nil, err
+	}
+	// ECDSA signatures are the concatenation of r and s, as described by
+	// RFC 9421, section 3.3.4.
+	size := (ecKey.Curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	r.FillBytes(signature[:size])
+	s.FillBytes(signature[size:])
+	return signature, nil
+}
+
+// verify verifies the signature of a signature base with key.
+func verify(key crypto.PublicKey, base, signature []byte) bool {
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, base, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPSS(key, crypto.SHA512, digest(sha512.New(), base), signature, rsaPSSOptions) == nil
+	}
+	ecKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return false
+	}
+	size := (ecKey.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return false
+	}
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+	return ecdsa.Verify(ecKey, ecdsaDigest(ecKey, base), r, s)
+}
+
+func ecdsaDigest(key *ecdsa.PublicKey, base []byte) []byte {
+	if key.Curve == elliptic.P384() {
+		return digest(sha512.New384(), base)
+	}
+	return digest(sha256.New(), base)
+}
@@ -0,0 +1,202 @@
+package httpsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/ecdsafile"
+)
+
+func TestSignVerify(t *testing.T) {
+	// Keys are stored and loaded with ecdsafile.
+	generated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	privatePEM, err := ecdsafile.StoreEcdsaPrivateKey(generated)
+	require.NoError(t, err)
+	publicPEM, err := ecdsafile.StoreEcdsaPublicKey(&generated.PublicKey)
+	require.NoError(t, err)
+	privateKey, err := ecdsafile.LoadEcdsaPrivateKey(privatePEM)
+	require.NoError(t, err)
+	publicKey, err := ecdsafile.LoadEcdsaPublicKey(publicPEM)
+	require.NoError(t, err)
+
+	signer, err := NewSigner(privateKey, "client-1", "Authorization", "X-Request-Id")
+	require.NoError(t, err)
+	verifier := &Verifier{Keys: StaticKeys(map[string]crypto.PublicKey{"client-1": publicKey})}
+
+	newRequest := func(t *testing.T, body string) *http.Request {
+		var bodyReader io.Reader
+		if body != "" {
+			bodyReader = strings.NewReader(body)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/pets/1?verbose=true", bodyReader)
+		req.Header.Set("Authorization", "Bearer token")
+		require.NoError(t, signer.Sign(req))
+		return req
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		req := newRequest(t, `{"name":"Fido"}`)
+		assert.Contains(t, req.Header.Get("Signature-Input"),
+			`sig1=("@method" "@authority" "@path" "@query" "authorization" "content-digest");created=`)
+		assert.Contains(t, req.Header.Get("Signature-Input"), `;keyid="client-1";alg="ecdsa-p256-sha256"`)
+		assert.True(t, strings.HasPrefix(req.Header.Get("Content-Digest"), "sha-256=:"))
+
+		keyID, err := verifier.Verify(req)
+		require.NoError(t, err)
+		assert.Equal(t, "client-1", keyID)
+
+		// The body is still readable after being verified.
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"Fido"}`, string(body))
+	})
+
+	t.Run("without body", func(t *testing.T) {
+		req := newRequest(t, "")
+		assert.Empty(t, req.Header.Get("Content-Digest"))
+		_, err := verifier.Verify(req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		for name, tamper := range map[string]func(req *http.Request){
+			"method": func(req *http.Request) { req.Method = http.MethodPut },
+			"host":   func(req *http.Request) { req.Host = "attacker.example" },
+			"path":   func(req *http.Request) { req.URL.Path = "/pets/2" },
+			"query":  func(req *http.Request) { req.URL.RawQuery = "verbose=false" },
+			"header": func(req *http.Request) { req.Header.Set("Authorization", "Bearer other") },
+			"body": func(req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"name":"Rex"}`))
+			},
+			"digest": func(req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"name":"Rex"}`))
+				req.Header.Set("Content-Digest", "sha-256=:pP+UXJtf7OV8V5dFeGZjt0u7/E1DEw6GHZBTdEcFzUc=:")
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				req := newRequest(t, `{"name":"Fido"}`)
+				tamper(req)
+				_, err := verifier.Verify(req)
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("authority", func(t *testing.T) {
+		// The default port of the scheme and the case of the host don't
+		// matter.
+		req := newRequest(t, "")
+		req.Host = "EXAMPLE.com:80"
+		_, err := verifier.Verify(req)
+		assert.NoError(t, err)
+
+		req.Host = "example.com:8080"
+		_, err = verifier.Verify(req)
+		assert.EqualError(t, err, "httpsig: invalid signature")
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		_, err := verifier.Verify(httptest.NewRequest(http.MethodGet, "/pets", nil))
+		assert.True(t, errors.Is(err, ErrNoSignature))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		other := &Verifier{Keys: StaticKeys(map[string]crypto.PublicKey{})}
+		_, err := other.Verify(newRequest(t, ""))
+		assert.EqualError(t, err, `httpsig: unknown key "client-1"`)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		other := &Verifier{Keys: StaticKeys(map[string]crypto.PublicKey{"client-1": &otherKey.PublicKey})}
+		_, err = other.Verify(newRequest(t, ""))
+		assert.EqualError(t, err, "httpsig: invalid signature")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		req := newRequest(t, "")
+		later := &Verifier{Keys: verifier.Keys, now: func() time.Time { return time.Now().Add(DefaultMaxAge + time.Minute) }}
+		_, err := later.Verify(req)
+		assert.EqualError(t, err, "httpsig: signature expired")
+
+		earlier := &Verifier{Keys: verifier.Keys, now: func() time.Time { return time.Now().Add(-time.Hour) }}
+		_, err = earlier.Verify(req)
+		assert.EqualError(t, err, "httpsig: signature created in the future")
+	})
+
+	t.Run("body size", func(t *testing.T) {
+		limited := &Verifier{Keys: verifier.Keys, MaxBodySize: 8}
+		_, err := limited.Verify(newRequest(t, `{"name":"Fido"}`))
+		var maxBytesErr *http.MaxBytesError
+		assert.True(t, errors.As(err, &maxBytesErr))
+
+		unlimited := &Verifier{Keys: verifier.Keys, MaxBodySize: -1}
+		_, err = unlimited.Verify(newRequest(t, `{"name":"Fido"}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("required headers", func(t *testing.T) {
+		strict := &Verifier{Keys: verifier.Keys, RequiredHeaders: []string{"X-Request-Id"}}
+		_, err := strict.Verify(newRequest(t, ""))
+		assert.EqualError(t, err, "httpsig: signature doesn't cover x-request-id")
+
+		req := httptest.NewRequest(http.MethodGet, "/pets", nil)
+		req.Header.Set("X-Request-Id", "42")
+		require.NoError(t, signer.Sign(req))
+		_, err = strict.Verify(req)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSignVerifyAlgorithms(t *testing.T) {
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for alg, key := range map[string]crypto.Signer{
+		"ecdsa-p384-sha384": p384Key,
+		"ed25519":           ed25519Key,
+		"rsa-pss-sha512":    rsaKey,
+	} {
+		t.Run(alg, func(t *testing.T) {
+			signer, err := NewSigner(key, alg)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"Fido"}`))
+			require.NoError(t, signer.Sign(req))
+			assert.Contains(t, req.Header.Get("Signature-Input"), `alg="`+alg+`"`)
+
+			verifier := &Verifier{Keys: StaticKeys(map[string]crypto.PublicKey{alg: key.Public()})}
+			keyID, err := verifier.Verify(req)
+			require.NoError(t, err)
+			assert.Equal(t, alg, keyID)
+
+			req.URL.Path = "/pets/1"
+			_, err = verifier.Verify(req)
+			assert.EqualError(t, err, "httpsig: invalid signature")
+		})
+	}
+
+	p224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	require.NoError(t, err)
+	_, err = NewSigner(p224Key, "p224")
+	assert.Error(t, err)
+}
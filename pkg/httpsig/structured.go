@@ -0,0 +1,101 @@
+package httpsig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// These parse the subset of the structured field values of RFC 8941 used by
+// the Signature-Input, Signature and Content-Digest headers.
+
+// splitTopLevel splits s at the separators which aren't within strings or
+// inner lists.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	inString, depth, start := false, 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseDictionary parses a dictionary, returning the raw values of its
+// members by key.
+func parseDictionary(s string) (map[string]string, error) {
+	members := make(map[string]string)
+	if strings.TrimSpace(s) == "" {
+		return members, nil
+	}
+	for _, member := range splitTopLevel(s, ',') {
+		key, value, found := strings.Cut(strings.TrimSpace(member), "=")
+		if key == "" {
+			return nil, fmt.Errorf("httpsig: invalid dictionary member %q", member)
+		}
+		if !found {
+			value = "?1"
+		}
+		members[key] = value
+	}
+	return members, nil
+}
+
+// firstMember returns the key and raw value of the first member of a
+// dictionary.
+func firstMember(s string) (string, string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", "", ErrNoSignature
+	}
+	member := strings.TrimSpace(splitTopLevel(s, ',')[0])
+	key, value, found := strings.Cut(member, "=")
+	if key == "" || !found {
+		return "", "", fmt.Errorf("httpsig: invalid dictionary member %q", member)
+	}
+	return key, value, nil
+}
+
+// parseSignatureParams parses the inner list of the components covered by a
+// signature, along with its parameters.
+func parseSignatureParams(s string) ([]string, map[string]string, error) {
+	parts := splitTopLevel(s, ';')
+	list := strings.TrimSpace(parts[0])
+	if !strings.HasPrefix(list, "(") || !strings.HasSuffix(list, ")") {
+		return nil, nil, fmt.Errorf("httpsig: invalid signature input %q", s)
+	}
+
+	var components []string
+	for _, item := range strings.Fields(list[1 : len(list)-1]) {
+		component, err := strconv.Unquote(item)
+		if err != nil || !strings.HasPrefix(item, `"`) {
+			return nil, nil, fmt.Errorf("httpsig: unsupported component %s", item)
+		}
+		components = append(components, component)
+	}
+
+	params := make(map[string]string)
+	for _, param := range parts[1:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("httpsig: invalid parameter %s", param)
+			}
+			value = unquoted
+		}
+		params[name] = value
+	}
+	return components, params, nil
+}
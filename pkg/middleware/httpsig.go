@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+// HTTPSignatureVerifier creates an Echo middleware verifying the HTTP Message
+// Signatures of requests, such as those of
+// securityprovider.SecurityProviderHTTPSignature. Requests whose signature is
+// missing or invalid get an HTTP/401, through errorHandler when it's set.
+// Handlers get the id of the key of the signature with
+// httpsig.KeyIDFromContext.
+func HTTPSignatureVerifier(verifier *httpsig.Verifier, errorHandler ErrorHandler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			keyID, err := verifier.Verify(req)
+			if err != nil {
+				httpErr := echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+				if errorHandler != nil {
+					return errorHandler(c, httpErr)
+				}
+				return httpErr
+			}
+			c.SetRequest(req.WithContext(httpsig.ContextWithKeyID(req.Context(), keyID)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
+)
+
+func TestHTTPSignatureVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := httpsig.NewSigner(key, "client-1")
+	require.NoError(t, err)
+	verifier := &httpsig.Verifier{Keys: httpsig.StaticKeys(map[string]crypto.PublicKey{"client-1": &key.PublicKey})}
+
+	e := echo.New()
+	e.Use(HTTPSignatureVerifier(verifier, nil))
+	e.POST("/resource", func(c echo.Context) error {
+		keyID, _ := httpsig.KeyIDFromContext(c.Request().Context())
+		return c.String(http.StatusOK, keyID)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/resource", strings.NewReader(`{"name":"Fido"}`))
+	require.NoError(t, signer.Sign(req))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "client-1", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resource", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Errors go through the error handler when it's set.
+	e = echo.New()
+	e.Use(HTTPSignatureVerifier(verifier, func(c echo.Context, err *echo.HTTPError) error {
+		return c.String(http.StatusForbidden, "custom: "+err.Message.(string))
+	}))
+	e.POST("/resource", func(c echo.Context) error {
+		t.Error("handler called for an unsigned request")
+		return nil
+	})
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resource", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "custom: httpsig: request isn't signed", rec.Body.String())
+}
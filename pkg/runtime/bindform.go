@@ -113,7 +113,46 @@ func MarshalForm(ptr interface{}, encodings map[string]RequestBodyEncoding) (url
 	return result, nil
 }
 
+// nullable is implemented by types.Nullable, whatever the type of its value.
+type nullable interface {
+	IsNull() bool
+	IsSpecified() bool
+}
+
+var nullableType = reflect.TypeOf((*nullable)(nil)).Elem()
+
+// isNullable returns whether t is a types.Nullable, which is a map from bool
+// to the type of its value.
+func isNullable(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && t.Key().Kind() == reflect.Bool && t.Implements(nullableType)
+}
+
+// bindFormNullable binds a types.Nullable. Forms have no null value, so an
+// empty value stands for null, and a field which is absent leaves the
+// Nullable unspecified.
+func bindFormNullable(v reflect.Value, form map[string][]string, files map[string][]*multipart.FileHeader, name string) (bool, error) {
+	result := reflect.MakeMapWithSize(v.Type(), 1)
+	if values := form[name]; len(values) == 1 && values[0] == "" {
+		result.SetMapIndex(reflect.ValueOf(false), reflect.Zero(v.Type().Elem()))
+		v.Set(result)
+		return true, nil
+	}
+
+	value := reflect.New(v.Type().Elem()).Elem()
+	hasData, err := bindFormImpl(value, form, files, name)
+	if err != nil || !hasData {
+		return hasData, err
+	}
+	result.SetMapIndex(reflect.ValueOf(true), value)
+	v.Set(result)
+	return true, nil
+}
+
 func bindFormImpl(v reflect.Value, form map[string][]string, files map[string][]*multipart.FileHeader, name string) (bool, error) {
+	if isNullable(v.Type()) {
+		return bindFormNullable(v, form, files, name)
+	}
+
 	var hasData bool
 	switch v.Kind() {
 	case reflect.Interface:
@@ -278,6 +317,17 @@ func bindAdditionalProperties(additionalProperties reflect.Value, parentStruct r
 }
 
 func marshalFormImpl(v reflect.Value, result url.Values, name string) {
+	if v.IsValid() && isNullable(v.Type()) {
+		// Null is marshaled as an empty value, and unspecified values are
+		// left out.
+		if n := v.Interface().(nullable); n.IsNull() {
+			result[name] = append(result[name], "")
+		} else if n.IsSpecified() {
+			marshalFormImpl(v.MapIndex(reflect.ValueOf(true)), result, name)
+		}
+		return
+	}
+
 	switch v.Kind() {
 	case reflect.Interface, reflect.Ptr:
 		marshalFormImpl(v.Elem(), result, name)
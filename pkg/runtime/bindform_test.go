@@ -167,6 +167,44 @@ func TestMarshalForm(t *testing.T) {
 	}
 }
 
+func TestBindFormNullable(t *testing.T) {
+	type testSubStruct struct {
+		Name types.Nullable[string] `json:"name,omitempty"`
+	}
+	type testStruct struct {
+		Int    types.Nullable[int]           `json:"int,omitempty"`
+		Slice  types.Nullable[[]int]         `json:"slice,omitempty"`
+		Struct types.Nullable[testSubStruct] `json:"struct,omitempty"`
+	}
+
+	testCases := map[string]testStruct{
+		"":                     {},
+		"int=":                 {Int: types.NewNullNullable[int]()},
+		"int=5":                {Int: types.NewNullableWithValue(5)},
+		"slice=1&slice=2":      {Slice: types.NewNullableWithValue([]int{1, 2})},
+		"struct[name]=":        {Struct: types.NewNullableWithValue(testSubStruct{Name: types.NewNullNullable[string]()})},
+		"struct[name]=example": {Struct: types.NewNullableWithValue(testSubStruct{Name: types.NewNullableWithValue("example")})},
+	}
+
+	for k, v := range testCases {
+		values, err := url.ParseQuery(k)
+		assert.NoError(t, err)
+		var result testStruct
+		err = BindForm(&result, values, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, v, result, k)
+
+		marshaled, err := MarshalForm(v, nil)
+		assert.NoError(t, err)
+		encoded, err := url.QueryUnescape(marshaled.Encode())
+		assert.NoError(t, err)
+		if k == "slice=1&slice=2" {
+			k = "slice[0]=1&slice[1]=2"
+		}
+		assert.Equal(t, k, encoded)
+	}
+}
+
 type fileData struct {
 	field    string
 	filename string
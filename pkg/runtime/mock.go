@@ -0,0 +1,279 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MockPreferences are the preferences of a client for the response of a
+// generated mock server, which selects the response of an operation from those
+// declared by the spec.
+type MockPreferences struct {
+	// StatusCode is the status code of the response, or 0 for the first
+	// successful one.
+	StatusCode int
+	// Example is the name of the example of the response, or empty for the
+	// first one.
+	Example string
+	// Accept lists the media ranges acceptable for the response, in order
+	// of preference. The first content type of the response is used when it
+	// is empty, or none of them match.
+	Accept []string
+}
+
+type mockPreferencesKey struct{}
+
+// ContextWithMockPreferences returns a copy of ctx carrying the preferences of
+// a client for the response of a mock server.
+func ContextWithMockPreferences(ctx context.Context, preferences MockPreferences) context.Context {
+	return context.WithValue(ctx, mockPreferencesKey{}, preferences)
+}
+
+// MockPreferencesFromContext returns the preferences carried by ctx, which are
+// empty when it carries none.
+func MockPreferencesFromContext(ctx context.Context) MockPreferences {
+	preferences, _ := ctx.Value(mockPreferencesKey{}).(MockPreferences)
+	return preferences
+}
+
+// ParseMockPreferences parses the preferences of a client from the values of
+// the Prefer and Accept headers of its request, such as:
+//
+//	Prefer: code=404, example=notFound
+//	Accept: application/xml;q=0.9, application/json
+func ParseMockPreferences(prefer, accept string) MockPreferences {
+	var preferences MockPreferences
+	for _, preference := range strings.FieldsFunc(prefer, func(r rune) bool { return r == ',' || r == ';' }) {
+		name, value, _ := strings.Cut(strings.TrimSpace(preference), "=")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "code":
+			if code, err := strconv.Atoi(value); err == nil {
+				preferences.StatusCode = code
+			}
+		case "example":
+			preferences.Example = value
+		}
+	}
+
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, found := params["q"]; found {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	for _, r := range ranges {
+		preferences.Accept = append(preferences.Accept, r.mediaType)
+	}
+	return preferences
+}
+
+// MockPreferencesMiddleware is an HTTP middleware which parses the preferences
+// of clients from the Prefer and Accept headers of their requests, and adds
+// them to the context of the requests for mock servers.
+func MockPreferencesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		preferences := ParseMockPreferences(r.Header.Get("Prefer"), r.Header.Get("Accept"))
+		next.ServeHTTP(w, r.WithContext(ContextWithMockPreferences(r.Context(), preferences)))
+	})
+}
+
+// MockResponse is a response of an operation, which a mock server may respond
+// with.
+type MockResponse struct {
+	// StatusCode is the status code of the response as declared by the spec,
+	// eg, 200, 2XX or default.
+	StatusCode string
+	// ContentType is the content type of the response, which is empty when
+	// it has no content.
+	ContentType string
+	// Examples are the examples of the content of the response.
+	Examples []MockExample
+}
+
+// MockExample is a named example of the content of a response.
+type MockExample struct {
+	Name  string
+	Value string
+}
+
+// MockSelection is the response selected by SelectMockResponse.
+type MockSelection struct {
+	// Index is the index of the response among those of the operation.
+	Index int
+	// StatusCode is the status code to respond with.
+	StatusCode int
+	// ContentType is the content type to respond with, which is the one
+	// preferred by the client when that of the response is a media range.
+	ContentType string
+	// Example is the content to respond with.
+	Example string
+}
+
+// SelectMockResponse selects the response of an operation to respond to a
+// request with, according to the preferences in its context. Without a
+// preferred status code, the first successful response is selected, or the
+// default one.
+func SelectMockResponse(ctx context.Context, responses []MockResponse) (MockSelection, error) {
+	preferences := MockPreferencesFromContext(ctx)
+
+	statusCode, selected := selectMockStatusCode(responses, preferences.StatusCode)
+	if selected == "" {
+		if preferences.StatusCode != 0 {
+			return MockSelection{}, fmt.Errorf("no response with status code %d", preferences.StatusCode)
+		}
+		return MockSelection{}, fmt.Errorf("no response")
+	}
+
+	index := -1
+	contentType := ""
+	for _, accepted := range preferences.Accept {
+		for i, response := range responses {
+			if response.StatusCode != selected || response.ContentType == "" {
+				continue
+			}
+			if matchMediaTypes(accepted, response.ContentType) {
+				index = i
+				contentType = response.ContentType
+				if strings.Contains(contentType, "*") && !strings.Contains(accepted, "*") {
+					contentType = accepted
+				}
+				break
+			}
+		}
+		if index >= 0 {
+			break
+		}
+	}
+	if index < 0 {
+		for i, response := range responses {
+			if response.StatusCode == selected {
+				index = i
+				contentType = response.ContentType
+				break
+			}
+		}
+	}
+
+	selection := MockSelection{
+		Index:       index,
+		StatusCode:  statusCode,
+		ContentType: contentType,
+	}
+	examples := responses[index].Examples
+	for _, example := range examples {
+		if example.Name == preferences.Example {
+			selection.Example = example.Value
+			return selection, nil
+		}
+	}
+	if len(examples) > 0 {
+		selection.Example = examples[0].Value
+	}
+	return selection, nil
+}
+
+// selectMockStatusCode returns the status code to respond with, along with
+// the status code of the responses to select among, as declared by the spec.
+// Without a preferred status code, that of the first successful response is
+// selected, or the default one, or the first one.
+func selectMockStatusCode(responses []MockResponse, preferred int) (int, string) {
+	if preferred != 0 {
+		code := strconv.Itoa(preferred)
+		var inRange, byDefault string
+		for _, response := range responses {
+			switch strings.ToUpper(response.StatusCode) {
+			case code:
+				return preferred, response.StatusCode
+			case code[:1] + "XX":
+				inRange = response.StatusCode
+			case "DEFAULT":
+				byDefault = response.StatusCode
+			}
+		}
+		if inRange != "" {
+			return preferred, inRange
+		}
+		if byDefault != "" {
+			return preferred, byDefault
+		}
+		return 0, ""
+	}
+
+	lowest := ""
+	for _, response := range responses {
+		if strings.HasPrefix(response.StatusCode, "2") && (lowest == "" || response.StatusCode < lowest) {
+			lowest = response.StatusCode
+		}
+	}
+	if lowest == "" {
+		for _, response := range responses {
+			if strings.EqualFold(response.StatusCode, "default") {
+				lowest = response.StatusCode
+				break
+			}
+		}
+	}
+	if lowest == "" && len(responses) > 0 {
+		lowest = responses[0].StatusCode
+	}
+	if lowest == "" {
+		return 0, ""
+	}
+	if code, err := strconv.Atoi(lowest); err == nil {
+		return code, lowest
+	}
+	if code, err := strconv.Atoi(lowest[:1]); err == nil && strings.EqualFold(lowest[1:], "XX") {
+		return code * 100, lowest
+	}
+	return http.StatusOK, lowest
+}
+
+// matchMediaTypes returns whether two media types match, when either of them
+// may be a media range such as */* or image/*.
+func matchMediaTypes(a, b string) bool {
+	aType, aSubtype, _ := strings.Cut(strings.ToLower(a), "/")
+	bType, bSubtype, _ := strings.Cut(strings.ToLower(b), "/")
+	if aType == "*" || bType == "*" {
+		return true
+	}
+	if aType != bType {
+		return false
+	}
+	return aSubtype == "*" || bSubtype == "*" || aSubtype == bSubtype
+}
@@ -0,0 +1,68 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMockPreferences(t *testing.T) {
+	preferences := ParseMockPreferences(`code=404, example="notFound"`, "application/xml;q=0.5, text/*;q=0, application/json")
+	assert.Equal(t, MockPreferences{
+		StatusCode: 404,
+		Example:    "notFound",
+		Accept:     []string{"application/json", "application/xml"},
+	}, preferences)
+
+	assert.Equal(t, MockPreferences{}, ParseMockPreferences("", ""))
+}
+
+func TestMockPreferencesMiddleware(t *testing.T) {
+	var preferences MockPreferences
+	handler := MockPreferencesMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		preferences = MockPreferencesFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Prefer", "code=201")
+	req.Header.Set("Accept", "text/plain")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, MockPreferences{StatusCode: 201, Accept: []string{"text/plain"}}, preferences)
+}
+
+func TestSelectMockResponse(t *testing.T) {
+	responses := []MockResponse{
+		{StatusCode: "200", ContentType: "application/json", Examples: []MockExample{{Name: "cat", Value: `{"name":"cat"}`}, {Name: "dog", Value: `{"name":"dog"}`}}},
+		{StatusCode: "200", ContentType: "text/plain", Examples: []MockExample{{Value: "cat"}}},
+		{StatusCode: "201", ContentType: "image/*"},
+		{StatusCode: "204"},
+		{StatusCode: "4XX", ContentType: "application/json", Examples: []MockExample{{Value: `{"code":400}`}}},
+		{StatusCode: "default", ContentType: "application/json", Examples: []MockExample{{Value: `{"code":500}`}}},
+	}
+	selectResponse := func(preferences MockPreferences) MockSelection {
+		selection, err := SelectMockResponse(ContextWithMockPreferences(context.Background(), preferences), responses)
+		require.NoError(t, err)
+		return selection
+	}
+
+	assert.Equal(t, MockSelection{Index: 0, StatusCode: 200, ContentType: "application/json", Example: `{"name":"cat"}`}, selectResponse(MockPreferences{}))
+	assert.Equal(t, MockSelection{Index: 0, StatusCode: 200, ContentType: "application/json", Example: `{"name":"dog"}`}, selectResponse(MockPreferences{Example: "dog"}))
+	assert.Equal(t, MockSelection{Index: 1, StatusCode: 200, ContentType: "text/plain", Example: "cat"}, selectResponse(MockPreferences{Accept: []string{"text/*", "application/json"}}))
+	assert.Equal(t, MockSelection{Index: 0, StatusCode: 200, ContentType: "application/json", Example: `{"name":"cat"}`}, selectResponse(MockPreferences{Accept: []string{"application/xml"}}))
+	assert.Equal(t, MockSelection{Index: 2, StatusCode: 201, ContentType: "image/png"}, selectResponse(MockPreferences{StatusCode: 201, Accept: []string{"image/png"}}))
+	assert.Equal(t, MockSelection{Index: 3, StatusCode: 204}, selectResponse(MockPreferences{StatusCode: 204}))
+	assert.Equal(t, MockSelection{Index: 4, StatusCode: 404, ContentType: "application/json", Example: `{"code":400}`}, selectResponse(MockPreferences{StatusCode: 404}))
+	assert.Equal(t, MockSelection{Index: 5, StatusCode: 503, ContentType: "application/json", Example: `{"code":500}`}, selectResponse(MockPreferences{StatusCode: 503}))
+
+	// Without a successful response, the default one is selected.
+	selection, err := SelectMockResponse(context.Background(), responses[4:])
+	require.NoError(t, err)
+	assert.Equal(t, MockSelection{Index: 1, StatusCode: http.StatusOK, ContentType: "application/json", Example: `{"code":500}`}, selection)
+
+	_, err = SelectMockResponse(ContextWithMockPreferences(context.Background(), MockPreferences{StatusCode: 404}), responses[:4])
+	assert.EqualError(t, err, "no response with status code 404")
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PageFetcher fetches the next page of a paginated operation, returning its
+// items, and whether there are more pages after it.
+type PageFetcher[T any] func(ctx context.Context) (items []T, more bool, err error)
+
+// PageIterator iterates over the items of the pages of a paginated operation,
+// fetching the pages as needed:
+//
+//	it := client.ListPetsItems(ctx, params)
+//	for it.Next() {
+//		pet := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type PageIterator[T any] struct {
+	ctx   context.Context
+	fetch PageFetcher[T]
+	items []T
+	item  T
+	more  bool
+	err   error
+}
+
+// NewPageIterator returns an iterator over the items of the pages fetched by
+// fetch.
+func NewPageIterator[T any](ctx context.Context, fetch PageFetcher[T]) *PageIterator[T] {
+	return &PageIterator[T]{ctx: ctx, fetch: fetch, more: true}
+}
+
+// Next advances the iterator to the next item, fetching the next page when the
+// items of the current one are exhausted. It returns false when there are no
+// more items, or fetching a page failed.
+func (it *PageIterator[T]) Next() bool {
+	for len(it.items) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+		it.items, it.more, it.err = it.fetch(it.ctx)
+		if it.err != nil {
+			return false
+		}
+	}
+	it.item, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Item returns the current item.
+func (it *PageIterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+// UnmarshalJSONPath unmarshals the value at a path of a JSON document into v.
+// The path is made of the names of object members separated by dots, such as
+// meta.next, and an empty path is the document itself. A missing value leaves
+// v unchanged.
+func UnmarshalJSONPath(data []byte, path string, v interface{}) error {
+	if path != "" {
+		for _, name := range strings.Split(path, ".") {
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal(data, &object); err != nil {
+				return fmt.Errorf("can't unmarshal %q of %s: %w", name, path, err)
+			}
+			value, found := object[name]
+			if !found {
+				return nil
+			}
+			data = value
+		}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("can't unmarshal %s: %w", path, err)
+	}
+	return nil
+}
+
+// NextLink returns the URL of the next page from the Link header of a
+// response, as described by RFC 8288, resolved against the URL of its request.
+// It returns nil when there is no next page. Links to another origin than the
+// one of the request are rejected, since following them would send the
+// credentials of the request to another server.
+func NextLink(rsp *http.Response) (*url.URL, error) {
+	for _, header := range rsp.Header.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			target, params, found := strings.Cut(strings.TrimSpace(link), ";")
+			if !found || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(name, "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+					if !strings.EqualFold(rel, "next") {
+						continue
+					}
+					next, err := url.Parse(target[1 : len(target)-1])
+					if err != nil {
+						return nil, fmt.Errorf("invalid next link: %w", err)
+					}
+					if rsp.Request != nil && rsp.Request.URL != nil {
+						requestURL := rsp.Request.URL
+						next = requestURL.ResolveReference(next)
+						if !strings.EqualFold(next.Scheme, requestURL.Scheme) || !strings.EqualFold(next.Host, requestURL.Host) {
+							return nil, fmt.Errorf("next link %s isn't on the origin of the request", next.Redacted())
+						}
+					}
+					return next, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageIterator(t *testing.T) {
+	pages := [][]int{{1, 2}, {}, {3}}
+	fetched := 0
+	it := NewPageIterator(context.Background(), func(ctx context.Context) ([]int, bool, error) {
+		page := pages[fetched]
+		fetched++
+		return page, fetched < len(pages), nil
+	})
+
+	var items []int
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 3, fetched)
+	assert.False(t, it.Next())
+
+	it = NewPageIterator(context.Background(), func(ctx context.Context) ([]int, bool, error) {
+		return nil, false, errors.New("unavailable")
+	})
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "unavailable")
+}
+
+func TestUnmarshalJSONPath(t *testing.T) {
+	data := []byte(`{"data":[{"name":"cat"}],"meta":{"next":"abc"}}`)
+
+	var names []struct{ Name string }
+	require.NoError(t, UnmarshalJSONPath(data, "data", &names))
+	assert.Equal(t, "cat", names[0].Name)
+
+	var next *string
+	require.NoError(t, UnmarshalJSONPath(data, "meta.next", &next))
+	assert.Equal(t, "abc", *next)
+
+	next = nil
+	require.NoError(t, UnmarshalJSONPath(data, "meta.previous", &next))
+	assert.Nil(t, next)
+
+	var all map[string]interface{}
+	require.NoError(t, UnmarshalJSONPath(data, "", &all))
+	assert.Len(t, all, 2)
+
+	assert.Error(t, UnmarshalJSONPath(data, "data.name", &next))
+}
+
+func TestNextLink(t *testing.T) {
+	requestURL, err := url.Parse("https://api.example.com/v1/pets?page=1")
+	require.NoError(t, err)
+	rsp := &http.Response{Header: http.Header{}, Request: &http.Request{URL: requestURL}}
+
+	next, err := NextLink(rsp)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+
+	rsp.Header.Set("Link", `<https://api.example.com/v1/pets?page=1>; rel="prev first", </v1/pets?page=3>; rel="next"`)
+	next, err = NextLink(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/pets?page=3", next.String())
+
+	// Links to other origins aren't followed.
+	for _, link := range []string{"https://evil.example.com/v1/pets?page=3", "http://api.example.com/v1/pets?page=3", "//api.example.com:8443/v1/pets"} {
+		rsp.Header.Set("Link", fmt.Sprintf(`<%s>; rel="next"`, link))
+		_, err = NextLink(rsp)
+		assert.ErrorContains(t, err, "isn't on the origin of the request", link)
+	}
+}
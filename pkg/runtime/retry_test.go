@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doerFunc performs requests with a function.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(statusCode int, headers ...string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+	for i := 0; i+1 < len(headers); i += 2 {
+		resp.Header.Set(headers[i], headers[i+1])
+	}
+	return resp
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+		StatusCodes:    []int{http.StatusServiceUnavailable},
+	}
+
+	t.Run("retries transient failures, rewinding bodies", func(t *testing.T) {
+		var bodies []string
+		doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			switch len(bodies) {
+			case 1:
+				return nil, errors.New("connection reset")
+			case 2:
+				return response(http.StatusServiceUnavailable, "Retry-After", "0"), nil
+			}
+			return response(http.StatusOK), nil
+		})
+		req, err := http.NewRequest(http.MethodPut, "http://example.com", bytes.NewReader([]byte(`{"name":"cat"}`)))
+		require.NoError(t, err)
+
+		resp, err := policy.Do(doer, req, true)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{`{"name":"cat"}`, `{"name":"cat"}`, `{"name":"cat"}`}, bodies)
+	})
+
+	t.Run("returns the last response", func(t *testing.T) {
+		attempts := 0
+		doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return response(http.StatusServiceUnavailable), nil
+		})
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		resp, err := policy.Do(doer, req, true)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("doesn't retry", func(t *testing.T) {
+		attempts := 0
+		doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return response(http.StatusServiceUnavailable, "Retry-After", "3600"), nil
+		})
+		newRequest := func(body io.Reader) *http.Request {
+			req, err := http.NewRequest(http.MethodPost, "http://example.com", body)
+			require.NoError(t, err)
+			return req
+		}
+
+		// Operations which aren't retryable.
+		_, err := policy.Do(doer, newRequest(nil), false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+
+		// Bodies which can't be rewound.
+		_, err = policy.Do(doer, newRequest(io.MultiReader(strings.NewReader("body"))), true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+
+		// Servers asking for a delay longer than the maximum backoff.
+		_, err = policy.Do(doer, newRequest(nil), true)
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return nil, errors.New("connection reset")
+		})
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		_, err = policy.Do(doer, req, true)
+		assert.EqualError(t, err, "connection reset")
+	})
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(10))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(2)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2015, time.October, 21, 7, 28, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, delay)
+
+	delay, ok = parseRetryAfter("Wed, 21 Oct 2015 07:29:00 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, delay)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
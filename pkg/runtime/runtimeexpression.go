@@ -0,0 +1,179 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// RuntimeExpressionSource holds the request which runtime expressions, such
+// as the URLs of callbacks, are evaluated against.
+type RuntimeExpressionSource struct {
+	// Request is the request which defined the callback.
+	Request *http.Request
+	// Body is the body of the request, which servers usually have read
+	// already. It's either its JSON encoding, as a []byte or a
+	// json.RawMessage, or a value which encodes to it, such as the body
+	// of a strict server request object.
+	Body interface{}
+	// PathParams holds the values of the path parameters of the request, by
+	// name, which routers don't expose in a common way.
+	PathParams map[string]string
+}
+
+// EvaluateRuntimeExpression evaluates an OpenAPI runtime expression, such as
+// the key of a callback, against the request of source. The expression is
+// either a single expression, such as "$request.body#/callbackUrl", or a
+// string embedding expressions within braces, such as
+// "https://{$request.header.host}/events?id={$request.query.id}".
+//
+// Expressions referring to the response, such as "$response.body", aren't
+// supported, since callbacks are defined by the request.
+func EvaluateRuntimeExpression(expression string, source RuntimeExpressionSource) (string, error) {
+	if strings.HasPrefix(expression, "$") {
+		return source.evaluate(expression)
+	}
+
+	var result strings.Builder
+	for {
+		start := strings.Index(expression, "{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(expression[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated runtime expression in %q", expression)
+		}
+		value, err := source.evaluate(expression[start+1 : start+end])
+		if err != nil {
+			return "", err
+		}
+		result.WriteString(expression[:start])
+		result.WriteString(value)
+		expression = expression[start+end+1:]
+	}
+	result.WriteString(expression)
+	return result.String(), nil
+}
+
+// evaluate evaluates a single runtime expression.
+func (s RuntimeExpressionSource) evaluate(expression string) (string, error) {
+	if s.Request == nil {
+		return "", errors.New("no request to evaluate runtime expressions against")
+	}
+
+	switch {
+	case expression == "$url":
+		scheme := "http"
+		if s.Request.TLS != nil {
+			scheme = "https"
+		}
+		if s.Request.URL.IsAbs() {
+			return s.Request.URL.String(), nil
+		}
+		return scheme + "://" + s.Request.Host + s.Request.URL.RequestURI(), nil
+	case expression == "$method":
+		return s.Request.Method, nil
+	case strings.HasPrefix(expression, "$request.header."):
+		return s.Request.Header.Get(strings.TrimPrefix(expression, "$request.header.")), nil
+	case strings.HasPrefix(expression, "$request.query."):
+		return s.Request.URL.Query().Get(strings.TrimPrefix(expression, "$request.query.")), nil
+	case strings.HasPrefix(expression, "$request.path."):
+		name := strings.TrimPrefix(expression, "$request.path.")
+		value, found := s.PathParams[name]
+		if !found {
+			return "", fmt.Errorf("path parameter %q of runtime expression %q isn't known", name, expression)
+		}
+		return value, nil
+	case expression == "$request.body" || strings.HasPrefix(expression, "$request.body#"):
+		value, err := s.bodyValue(strings.TrimPrefix(strings.TrimPrefix(expression, "$request.body"), "#"))
+		if err != nil {
+			return "", fmt.Errorf("error evaluating runtime expression %q: %w", expression, err)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("unsupported runtime expression %q", expression)
+	}
+}
+
+// bodyValue returns the value at the JSON pointer within the request body.
+func (s RuntimeExpressionSource) bodyValue(pointer string) (string, error) {
+	var buf []byte
+	switch body := s.Body.(type) {
+	case nil:
+		return "", errors.New("the request body isn't available")
+	case []byte:
+		buf = body
+	case json.RawMessage:
+		buf = body
+	default:
+		var err error
+		if buf, err = json.Marshal(body); err != nil {
+			return "", err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	if pointer != "" {
+		if !strings.HasPrefix(pointer, "/") {
+			return "", fmt.Errorf("invalid JSON pointer %q", pointer)
+		}
+		for _, token := range strings.Split(pointer[1:], "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			switch v := value.(type) {
+			case map[string]interface{}:
+				item, found := v[token]
+				if !found {
+					return "", fmt.Errorf("the request body has no value at %q", pointer)
+				}
+				value = item
+			case []interface{}:
+				index, err := strconv.Atoi(token)
+				if err != nil || index < 0 || index >= len(v) {
+					return "", fmt.Errorf("the request body has no value at %q", pointer)
+				}
+				value = v[index]
+			default:
+				return "", fmt.Errorf("the request body has no value at %q", pointer)
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case nil:
+		return "", nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
@@ -0,0 +1,54 @@
+package runtime
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateRuntimeExpression(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://api.example.com/subscriptions?id=42", nil)
+	req.Header.Set("X-Tenant", "acme")
+	body := map[string]interface{}{
+		"callbackUrl": "https://subscriber.example.com/hooks",
+		"events":      []string{"created", "deleted"},
+		"a/b":         3,
+	}
+	source := RuntimeExpressionSource{Request: req, Body: body, PathParams: map[string]string{"user": "bob"}}
+
+	tests := map[string]string{
+		"$request.body#/callbackUrl":                                          "https://subscriber.example.com/hooks",
+		"{$request.body#/callbackUrl}/events":                                 "https://subscriber.example.com/hooks/events",
+		"{$request.body#/events/1}?id={$request.query.id}":                    "deleted?id=42",
+		"{$request.body#/a~1b}":                                               "3",
+		"{$request.body#/events}":                                             `["created","deleted"]`,
+		"https://{$request.header.x-tenant}.example.com/{$request.path.user}": "https://acme.example.com/bob",
+		"{$method} {$url}":                                                    "POST http://api.example.com/subscriptions?id=42",
+		"https://static.example.com/hooks":                                    "https://static.example.com/hooks",
+	}
+	for expression, expected := range tests {
+		value, err := EvaluateRuntimeExpression(expression, source)
+		require.NoError(t, err, expression)
+		assert.Equal(t, expected, value, expression)
+	}
+
+	source.Body = []byte(`{"callbackUrl": "https://raw.example.com"}`)
+	value, err := EvaluateRuntimeExpression("{$request.body#/callbackUrl}", source)
+	require.NoError(t, err)
+	assert.Equal(t, "https://raw.example.com", value)
+
+	for _, expression := range []string{
+		"{$request.body#/missing}",
+		"{$request.path.missing}",
+		"{$response.body#/id}",
+		"{$request.body",
+	} {
+		_, err := EvaluateRuntimeExpression(expression, source)
+		assert.Error(t, err, expression)
+	}
+
+	_, err = EvaluateRuntimeExpression("$request.body#/callbackUrl", RuntimeExpressionSource{Request: req})
+	assert.Error(t, err)
+}
@@ -0,0 +1,334 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServerSentEvent is an event of a text/event-stream response, whose data is
+// a value of type T. Strings are sent as is, and other values as JSON.
+type ServerSentEvent[T any] struct {
+	ID    string
+	Event string        // The type of the event, if other than message
+	Retry time.Duration // The reconnection delay asked to clients, if any
+	Data  T
+}
+
+// EventStreamWriter writes the server-sent events of a text/event-stream
+// response, flushing each of them to the client.
+type EventStreamWriter[T any] struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+// NewEventStreamWriter returns a writer of server-sent events to w, which stops
+// writing when ctx is done. The headers already written to w are flushed, so
+// that clients see the stream open before the first event.
+func NewEventStreamWriter[T any](ctx context.Context, w io.Writer) *EventStreamWriter[T] {
+	_ = flush(w)
+	return &EventStreamWriter[T]{ctx: ctx, w: w}
+}
+
+// Context returns the context of the stream, which is done when the client
+// went away.
+func (w *EventStreamWriter[T]) Context() context.Context {
+	return w.ctx
+}
+
+// Write writes an event of type message with data.
+func (w *EventStreamWriter[T]) Write(data T) error {
+	return w.WriteEvent(ServerSentEvent[T]{Data: data})
+}
+
+// WriteEvent writes event, returning the error of the context once it's done.
+// Its ID and type can't contain line breaks or NUL characters, which would
+// write other fields, while the lines of its data are written as data lines.
+func (w *EventStreamWriter[T]) WriteEvent(event ServerSentEvent[T]) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(event.ID, "\r\n\x00") {
+		return fmt.Errorf("invalid event ID %q: it can't contain CR, LF or NUL", event.ID)
+	}
+	if strings.ContainsAny(event.Event, "\r\n\x00") {
+		return fmt.Errorf("invalid event type %q: it can't contain CR, LF or NUL", event.Event)
+	}
+	data, err := marshalEventData(event.Data)
+	if err != nil {
+		return fmt.Errorf("error marshaling event data: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if event.ID != "" {
+		fmt.Fprintf(&buf, "id: %s\n", event.ID)
+	}
+	if event.Event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", event.Event)
+	}
+	if event.Retry > 0 {
+		fmt.Fprintf(&buf, "retry: %d\n", event.Retry.Milliseconds())
+	}
+	for _, line := range eventLineBreaks.Split(data, -1) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteByte('\n')
+
+	if _, err := w.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return flush(w.w)
+}
+
+// NDJSONWriter writes the values of an application/x-ndjson response, one JSON
+// document per line, flushing each of them to the client.
+type NDJSONWriter[T any] struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+// NewNDJSONWriter returns a writer of JSON lines to w, which stops writing when
+// ctx is done. The headers already written to w are flushed, so that clients
+// see the stream open before the first value.
+func NewNDJSONWriter[T any](ctx context.Context, w io.Writer) *NDJSONWriter[T] {
+	_ = flush(w)
+	return &NDJSONWriter[T]{ctx: ctx, w: w}
+}
+
+// Context returns the context of the stream, which is done when the client
+// went away.
+func (w *NDJSONWriter[T]) Context() context.Context {
+	return w.ctx
+}
+
+// Write writes value as a line of JSON, returning the error of the context
+// once it's done.
+func (w *NDJSONWriter[T]) Write(value T) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	line, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error marshaling value: %w", err)
+	}
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return flush(w.w)
+}
+
+// flush sends the data buffered by w to the client, for the writers of the
+// standard library and of frameworks such as fiber.
+func flush(w io.Writer) error {
+	switch f := w.(type) {
+	case interface{ Flush() error }:
+		return f.Flush()
+	case http.Flusher:
+		f.Flush()
+	}
+	return nil
+}
+
+// eventLineBreaks matches the line breaks of event streams, which are CRLF,
+// CR or LF.
+var eventLineBreaks = regexp.MustCompile("\r\n|\r|\n")
+
+func marshalEventData(data interface{}) (string, error) {
+	if s, ok := data.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(data)
+	return string(b), err
+}
+
+// EventStreamReader reads the server-sent events of a text/event-stream
+// response as they are received, decoding their data into values of type T:
+//
+//	events, err := client.WatchPetsEventStream(ctx)
+//	if err != nil {
+//		...
+//	}
+//	defer events.Close()
+//	for events.Next() {
+//		pet := events.Item()
+//	}
+//	if err := events.Err(); err != nil {
+//		...
+//	}
+type EventStreamReader[T any] struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	event   ServerSentEvent[T]
+	err     error
+}
+
+// NewEventStreamReader returns a reader of the server-sent events of body,
+// which it closes once the stream ends.
+func NewEventStreamReader[T any](body io.ReadCloser) *EventStreamReader[T] {
+	return &EventStreamReader[T]{body: body, scanner: newLineScanner(body)}
+}
+
+// Next advances the reader to the next event carrying data. It returns false
+// when the stream ended, or reading it failed.
+func (r *EventStreamReader[T]) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	var event ServerSentEvent[T]
+	var data []string
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		if line == "" {
+			if data == nil {
+				// Events without data are only dispatched to update the
+				// reconnection state, which isn't kept by the reader.
+				event = ServerSentEvent[T]{}
+				continue
+			}
+			if r.err = unmarshalEventData(strings.Join(data, "\n"), &event.Data); r.err != nil {
+				r.Close()
+				return false
+			}
+			r.event = event
+			return true
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			event.ID = value
+		case "event":
+			event.Event = value
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil {
+				event.Retry = time.Duration(ms) * time.Millisecond
+			}
+		case "data":
+			data = append(data, value)
+		}
+	}
+	r.err = r.scanner.Err()
+	r.Close()
+	return false
+}
+
+// Event returns the current event.
+func (r *EventStreamReader[T]) Event() ServerSentEvent[T] {
+	return r.event
+}
+
+// Item returns the data of the current event.
+func (r *EventStreamReader[T]) Item() T {
+	return r.event.Data
+}
+
+// Err returns the error which stopped the reader, if any.
+func (r *EventStreamReader[T]) Err() error {
+	return r.err
+}
+
+// Close closes the body of the stream, which stops the reader.
+func (r *EventStreamReader[T]) Close() error {
+	return r.body.Close()
+}
+
+func unmarshalEventData(data string, v interface{}) error {
+	if s, ok := v.(*string); ok {
+		*s = data
+		return nil
+	}
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		return fmt.Errorf("error unmarshaling event data: %w", err)
+	}
+	return nil
+}
+
+// NDJSONReader reads the values of an application/x-ndjson response as they
+// are received, one JSON document per line, in the same way as an
+// EventStreamReader.
+type NDJSONReader[T any] struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	item    T
+	err     error
+}
+
+// NewNDJSONReader returns a reader of the JSON lines of body, which it closes
+// once the stream ends.
+func NewNDJSONReader[T any](body io.ReadCloser) *NDJSONReader[T] {
+	return &NDJSONReader[T]{body: body, scanner: newLineScanner(body)}
+}
+
+// Next advances the reader to the next value, skipping blank lines. It returns
+// false when the stream ended, or reading it failed.
+func (r *NDJSONReader[T]) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var item T
+		if err := json.Unmarshal(line, &item); err != nil {
+			r.err = fmt.Errorf("error unmarshaling line: %w", err)
+			r.Close()
+			return false
+		}
+		r.item = item
+		return true
+	}
+	r.err = r.scanner.Err()
+	r.Close()
+	return false
+}
+
+// Item returns the current value.
+func (r *NDJSONReader[T]) Item() T {
+	return r.item
+}
+
+// Err returns the error which stopped the reader, if any.
+func (r *NDJSONReader[T]) Err() error {
+	return r.err
+}
+
+// Close closes the body of the stream, which stops the reader.
+func (r *NDJSONReader[T]) Close() error {
+	return r.body.Close()
+}
+
+// maxStreamLineSize is the maximum size of the lines of streams, which are
+// allowed to be larger than those of bufio.Scanner by default.
+const maxStreamLineSize = 1 << 20
+
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxStreamLineSize)
+	return scanner
+}
@@ -0,0 +1,76 @@
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type streamItem struct {
+	Name string `json:"name"`
+}
+
+func TestEventStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+	events := NewEventStreamWriter[streamItem](context.Background(), rec)
+	assert.True(t, rec.Flushed)
+
+	require.NoError(t, events.Write(streamItem{Name: "cat"}))
+	require.NoError(t, events.WriteEvent(ServerSentEvent[streamItem]{ID: "2", Event: "update", Retry: time.Second, Data: streamItem{Name: "dog"}}))
+	assert.Equal(t, "data: {\"name\":\"cat\"}\n\nid: 2\nevent: update\nretry: 1000\ndata: {\"name\":\"dog\"}\n\n", rec.Body.String())
+
+	reader := NewEventStreamReader[streamItem](io.NopCloser(strings.NewReader(": comment\r\n" + rec.Body.String())))
+	require.True(t, reader.Next())
+	assert.Equal(t, "cat", reader.Item().Name)
+	require.True(t, reader.Next())
+	assert.Equal(t, ServerSentEvent[streamItem]{ID: "2", Event: "update", Retry: time.Second, Data: streamItem{Name: "dog"}}, reader.Event())
+	assert.False(t, reader.Next())
+	require.NoError(t, reader.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, NewEventStreamWriter[streamItem](ctx, rec).Write(streamItem{}), context.Canceled)
+}
+
+func TestEventStreamText(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, NewEventStreamWriter[string](context.Background(), rec).Write("hello\nworld"))
+	assert.Equal(t, "data: hello\ndata: world\n\n", rec.Body.String())
+
+	reader := NewEventStreamReader[string](io.NopCloser(rec.Body))
+	require.True(t, reader.Next())
+	assert.Equal(t, "hello\nworld", reader.Item())
+
+	// Data can't write other fields or events, whatever its line breaks.
+	rec = httptest.NewRecorder()
+	events := NewEventStreamWriter[string](context.Background(), rec)
+	require.NoError(t, events.Write("a\rid: 1\r\n\r\nb"))
+	assert.Equal(t, "data: a\ndata: id: 1\ndata: \ndata: b\n\n", rec.Body.String())
+
+	// Neither can IDs and event types.
+	for _, event := range []ServerSentEvent[string]{{ID: "1\nevent: x"}, {ID: "1\r"}, {ID: "1\x00"}, {Event: "x\r\ndata: y"}} {
+		assert.Error(t, events.WriteEvent(event))
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	lines := NewNDJSONWriter[streamItem](context.Background(), rec)
+	require.NoError(t, lines.Write(streamItem{Name: "cat"}))
+	require.NoError(t, lines.Write(streamItem{Name: "dog"}))
+	assert.Equal(t, "{\"name\":\"cat\"}\n{\"name\":\"dog\"}\n", rec.Body.String())
+
+	reader := NewNDJSONReader[streamItem](io.NopCloser(strings.NewReader(rec.Body.String() + "\n{")))
+	var names []string
+	for reader.Next() {
+		names = append(names, reader.Item().Name)
+	}
+	assert.Equal(t, []string{"cat", "dog"}, names)
+	assert.Error(t, reader.Err())
+}
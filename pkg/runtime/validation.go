@@ -0,0 +1,155 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Validator is implemented by the generated models when validation code is
+// generated. Validate checks the value against the constraints of its schema.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError describes a single value which doesn't satisfy the
+// constraints of its schema.
+type ValidationError struct {
+	// Path is the JSON pointer of the invalid value, relative to the value
+	// which was validated, eg, "/pets/0/name". It is empty when the validated
+	// value itself is invalid.
+	Path string
+	// Message describes the violated constraint.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors holds all the constraint violations found when validating
+// a value.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add records a violation of a constraint by the value at path.
+func (e *ValidationErrors) Add(path string, format string, args ...interface{}) {
+	*e = append(*e, &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Merge records the violations found when validating the nested value at
+// path, as returned by its Validate method.
+func (e *ValidationErrors) Merge(path string, err error) {
+	if err == nil {
+		return
+	}
+	var validationErrors ValidationErrors
+	var validationError *ValidationError
+	switch {
+	case errors.As(err, &validationErrors):
+		for _, nested := range validationErrors {
+			*e = append(*e, &ValidationError{Path: path + nested.Path, Message: nested.Message})
+		}
+	case errors.As(err, &validationError):
+		*e = append(*e, &ValidationError{Path: path + validationError.Path, Message: validationError.Message})
+	default:
+		*e = append(*e, &ValidationError{Path: path, Message: err.Error()})
+	}
+}
+
+// Err returns the recorded violations as an error, or nil if there are none.
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Validate validates v if it implements Validator, and returns nil otherwise.
+// It's used for values whose types may or may not have been generated with
+// validation, such as types from other packages.
+func Validate(v interface{}) error {
+	if validator, ok := v.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// JSONPointerToken escapes a map key or property name for use as a reference
+// token of a JSON pointer, as described in RFC 6901.
+func JSONPointerToken(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+var patterns sync.Map
+
+// MatchesPattern reports whether s matches the regular expression pattern.
+// Compiled patterns are cached, since the same patterns are used over and
+// over by validation code.
+func MatchesPattern(pattern string, s string) bool {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	return re.(*regexp.Regexp).MatchString(s)
+}
+
+// IsMultipleOf reports whether v is a multiple of divisor, allowing for the
+// imprecision of floating point numbers.
+func IsMultipleOf(v float64, divisor float64) bool {
+	if divisor == 0 {
+		return false
+	}
+	quotient := v / divisor
+	return math.Abs(quotient-math.Round(quotient)) < 1e-9
+}
+
+// HasUniqueItems reports whether all the items of the given slice have
+// different JSON representations.
+func HasUniqueItems(slice interface{}) bool {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		return true
+	}
+	seen := make(map[string]bool, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		buf, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			// Items which can't be represented can't be compared either.
+			continue
+		}
+		if seen[string(buf)] {
+			return false
+		}
+		seen[string(buf)] = true
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatedValue struct {
+	err error
+}
+
+func (v validatedValue) Validate() error {
+	return v.err
+}
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	assert.NoError(t, errs.Err())
+
+	errs.Add("/name", "length must be at least %d", 2)
+	nested := ValidationErrors{
+		{Path: "/0", Message: "must be one of [a b]"},
+		{Path: "", Message: "must have unique items"},
+	}
+	errs.Merge("/tags", nested)
+	errs.Merge("/owner", &ValidationError{Path: "/email", Message: "must match pattern .+@.+"})
+	errs.Merge("/other", errors.New("some failure"))
+	errs.Merge("/valid", nil)
+
+	err := errs.Err()
+	assert.EqualError(t, err, "/name: length must be at least 2; "+
+		"/tags/0: must be one of [a b]; "+
+		"/tags: must have unique items; "+
+		"/owner/email: must match pattern .+@.+; "+
+		"/other: some failure")
+
+	var validationErrors ValidationErrors
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Len(t, validationErrors, 5)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("not a validator"))
+	assert.NoError(t, Validate(validatedValue{}))
+	assert.EqualError(t, Validate(validatedValue{err: errors.New("invalid")}), "invalid")
+}
+
+func TestJSONPointerToken(t *testing.T) {
+	assert.Equal(t, "plain", JSONPointerToken("plain"))
+	assert.Equal(t, "a~1b~0c", JSONPointerToken("a/b~c"))
+}
+
+func TestMatchesPattern(t *testing.T) {
+	assert.True(t, MatchesPattern("^[a-z]+$", "abc"))
+	assert.False(t, MatchesPattern("^[a-z]+$", "ABC"))
+	// Cached patterns give the same results.
+	assert.True(t, MatchesPattern("^[a-z]+$", "xyz"))
+}
+
+func TestIsMultipleOf(t *testing.T) {
+	assert.True(t, IsMultipleOf(4.5, 0.5))
+	assert.True(t, IsMultipleOf(0.3, 0.1))
+	assert.False(t, IsMultipleOf(4.2, 0.5))
+	assert.False(t, IsMultipleOf(1, 0))
+}
+
+func TestHasUniqueItems(t *testing.T) {
+	assert.True(t, HasUniqueItems([]string{"a", "b"}))
+	assert.False(t, HasUniqueItems([]string{"a", "b", "a"}))
+	assert.False(t, HasUniqueItems([]map[string]int{{"a": 1, "b": 2}, {"b": 2, "a": 1}}))
+	assert.True(t, HasUniqueItems(nil))
+}
@@ -0,0 +1,312 @@
+package securityprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	// ErrSecurityProviderOAuth2NoTokenURL indicates an OAuth2 configuration
+	// without a token URL.
+	ErrSecurityProviderOAuth2NoTokenURL = SecurityProviderError("no token URL specified for oauth2")
+
+	// defaultOAuth2ExpiryDelta is how long before their expiry tokens are
+	// refreshed by default.
+	defaultOAuth2ExpiryDelta = 10 * time.Second
+	// oauth2TokenRequestTimeout bounds the token requests, which aren't bound
+	// to the context of any request since their token is shared.
+	oauth2TokenRequestTimeout = 30 * time.Second
+)
+
+// OAuth2Config configures a SecurityProviderOAuth2.
+type OAuth2Config struct {
+	// TokenURL is the token endpoint of the authorization server.
+	TokenURL string
+	// ClientID and ClientSecret authenticate the client with the
+	// authorization server.
+	ClientID     string
+	ClientSecret string
+	// Scopes are the scopes requested for tokens, if any.
+	Scopes []string
+	// RefreshToken, when set, gets tokens with the refresh_token grant rather
+	// than the client_credentials grant. The refresh tokens issued along with
+	// new tokens replace it.
+	RefreshToken string
+	// EndpointParams are additional parameters of token requests, such as an
+	// audience.
+	EndpointParams url.Values
+	// AuthInParams sends the client credentials in the parameters of token
+	// requests rather than with basic authentication.
+	AuthInParams bool
+	// HTTPClient sends token requests, which is http.DefaultClient when nil.
+	HTTPClient *http.Client
+	// ExpiryDelta is how long before their expiry tokens are refreshed, which
+	// is 10 seconds when zero.
+	ExpiryDelta time.Duration
+}
+
+// OAuth2ConfigFromSpec returns the configuration of an OAuth2 provider for the
+// oauth2 security scheme of a spec with the given name, with the token URL and
+// the scopes of its client credentials flow, or else of any other flow with a
+// token URL, which may be used with a refresh token. The client credentials
+// are left to be filled in.
+func OAuth2ConfigFromSpec(spec *openapi3.T, schemeName string) (OAuth2Config, error) {
+	if spec == nil || spec.Components == nil || spec.Components.SecuritySchemes == nil {
+		return OAuth2Config{}, fmt.Errorf("no security scheme %q", schemeName)
+	}
+	ref := spec.Components.SecuritySchemes[schemeName]
+	if ref == nil || ref.Value == nil {
+		return OAuth2Config{}, fmt.Errorf("no security scheme %q", schemeName)
+	}
+	scheme := ref.Value
+	if scheme.Type != "oauth2" || scheme.Flows == nil {
+		return OAuth2Config{}, fmt.Errorf("security scheme %q isn't an oauth2 scheme", schemeName)
+	}
+
+	for _, flow := range []*openapi3.OAuthFlow{
+		scheme.Flows.ClientCredentials,
+		scheme.Flows.AuthorizationCode,
+		scheme.Flows.Password,
+	} {
+		if flow == nil || flow.TokenURL == "" {
+			continue
+		}
+		scopes := make([]string, 0, len(flow.Scopes))
+		for scope := range flow.Scopes {
+			scopes = append(scopes, scope)
+		}
+		sort.Strings(scopes)
+		return OAuth2Config{TokenURL: flow.TokenURL, Scopes: scopes}, nil
+	}
+	return OAuth2Config{}, fmt.Errorf("security scheme %q has no flow with a token URL", schemeName)
+}
+
+// OAuth2Token is an access token issued by an authorization server.
+type OAuth2Token struct {
+	AccessToken  string
+	TokenType    string
+	RefreshToken string
+	// Expiry is when the token expires, which is zero when it doesn't.
+	Expiry time.Time
+}
+
+// OAuth2Error is returned when an authorization server refuses to issue a
+// token, as described by RFC 6749, section 5.2.
+type OAuth2Error struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+// Error implements the error interface.
+func (e *OAuth2Error) Error() string {
+	msg := fmt.Sprintf("oauth2: token request failed with status %d", e.StatusCode)
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// NewSecurityProviderOAuth2 provides a SecurityProvider, which gets access
+// tokens from an OAuth2 authorization server with the client credentials or
+// refresh token grants.
+func NewSecurityProviderOAuth2(config OAuth2Config) (*SecurityProviderOAuth2, error) {
+	if config.TokenURL == "" {
+		return nil, ErrSecurityProviderOAuth2NoTokenURL
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	if config.ExpiryDelta == 0 {
+		config.ExpiryDelta = defaultOAuth2ExpiryDelta
+	}
+	return &SecurityProviderOAuth2{
+		config:       config,
+		refreshToken: config.RefreshToken,
+		now:          time.Now,
+	}, nil
+}
+
+// SecurityProviderOAuth2 sends an access token as part of an Authorization
+// header along with a request. Tokens are cached until shortly before they
+// expire, and concurrent requests needing a new token share a single token
+// request.
+type SecurityProviderOAuth2 struct {
+	config OAuth2Config
+	now    func() time.Time
+
+	mu           sync.Mutex
+	token        *OAuth2Token
+	refreshToken string
+	call         *oauth2TokenCall
+}
+
+// oauth2TokenCall is a token request, whose result is shared by the requests
+// waiting for it.
+type oauth2TokenCall struct {
+	done  chan struct{}
+	token *OAuth2Token
+	err   error
+}
+
+// Intercept will attach an Authorization header to the request with a valid
+// access token, getting a new one when needed.
+func (s *SecurityProviderOAuth2) Intercept(ctx context.Context, req *http.Request) error {
+	token, err := s.Token(ctx)
+	if err != nil {
+		return err
+	}
+	tokenType := token.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+	req.Header.Set("Authorization", tokenType+" "+token.AccessToken)
+	return nil
+}
+
+// Token returns the cached access token, or gets a new one when it's missing
+// or about to expire.
+func (s *SecurityProviderOAuth2) Token(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	if s.token != nil && (s.token.Expiry.IsZero() || s.now().Add(s.config.ExpiryDelta).Before(s.token.Expiry)) {
+		token := s.token
+		s.mu.Unlock()
+		return token, nil
+	}
+	call := s.call
+	if call == nil {
+		call = &oauth2TokenCall{done: make(chan struct{})}
+		s.call = call
+		go s.fetch(call, s.refreshToken)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Invalidate drops the cached access token, such as when it was revoked, so
+// that the next request gets a new one.
+func (s *SecurityProviderOAuth2) Invalidate() {
+	s.mu.Lock()
+	s.token = nil
+	s.mu.Unlock()
+}
+
+// fetch gets a new token, and caches it.
+func (s *SecurityProviderOAuth2) fetch(call *oauth2TokenCall, refreshToken string) {
+	ctx, cancel := context.WithTimeout(context.Background(), oauth2TokenRequestTimeout)
+	defer cancel()
+	token, err := s.requestToken(ctx, refreshToken)
+
+	s.mu.Lock()
+	if err == nil {
+		s.token = token
+		if token.RefreshToken != "" {
+			s.refreshToken = token.RefreshToken
+		}
+	}
+	s.call = nil
+	s.mu.Unlock()
+
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+// requestToken sends a token request, as described by RFC 6749, sections 4.4
+// and 6.
+func (s *SecurityProviderOAuth2) requestToken(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	params := url.Values{}
+	for name, values := range s.config.EndpointParams {
+		params[name] = append([]string(nil), values...)
+	}
+	if refreshToken != "" {
+		params.Set("grant_type", "refresh_token")
+		params.Set("refresh_token", refreshToken)
+	} else {
+		params.Set("grant_type", "client_credentials")
+	}
+	if len(s.config.Scopes) != 0 {
+		params.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	if s.config.AuthInParams {
+		params.Set("client_id", s.config.ClientID)
+		if s.config.ClientSecret != "" {
+			params.Set("client_secret", s.config.ClientSecret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: invalid token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if !s.config.AuthInParams {
+		req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+	}
+
+	rsp, err := s.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: token request failed: %w", err)
+	}
+	defer func() { _ = rsp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oauth2: can't read token response: %w", err)
+	}
+
+	var tokenResponse struct {
+		AccessToken      string      `json:"access_token"`
+		TokenType        string      `json:"token_type"`
+		RefreshToken     string      `json:"refresh_token"`
+		ExpiresIn        json.Number `json:"expires_in"`
+		Error            string      `json:"error"`
+		ErrorDescription string      `json:"error_description"`
+	}
+	decodeErr := json.Unmarshal(body, &tokenResponse)
+	if rsp.StatusCode/100 != 2 {
+		return nil, &OAuth2Error{StatusCode: rsp.StatusCode, Code: tokenResponse.Error, Description: tokenResponse.ErrorDescription}
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("oauth2: can't unmarshal token response: %w", decodeErr)
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, errors.New("oauth2: token response has no access token")
+	}
+
+	token := &OAuth2Token{
+		AccessToken:  tokenResponse.AccessToken,
+		TokenType:    tokenResponse.TokenType,
+		RefreshToken: tokenResponse.RefreshToken,
+	}
+	if tokenResponse.ExpiresIn != "" {
+		seconds, err := strconv.ParseInt(string(tokenResponse.ExpiresIn), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("oauth2: invalid expires_in %q: %w", tokenResponse.ExpiresIn, err)
+		}
+		if seconds > 0 {
+			token.Expiry = s.now().Add(time.Duration(seconds) * time.Second)
+		}
+	}
+	return token, nil
+}
@@ -0,0 +1,171 @@
+package securityprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer is an authorization server issuing numbered tokens.
+type tokenServer struct {
+	*httptest.Server
+	requests int32
+	forms    chan map[string]string
+}
+
+func newTokenServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, n int32)) *tokenServer {
+	s := &tokenServer{forms: make(chan map[string]string, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form := map[string]string{}
+		for name := range r.PostForm {
+			form[name] = r.PostForm.Get(name)
+		}
+		if id, secret, ok := r.BasicAuth(); ok {
+			form["basic"] = id + ":" + secret
+		}
+		s.forms <- form
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r, atomic.AddInt32(&s.requests, 1))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestSecurityProviderOAuth2(t *testing.T) {
+	t.Run("client credentials", func(t *testing.T) {
+		server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = fmt.Fprintf(w, `{"access_token":"token%d","token_type":"bearer","expires_in":3600}`, n)
+		})
+		provider, err := NewSecurityProviderOAuth2(OAuth2Config{
+			TokenURL:     server.URL,
+			ClientID:     "client",
+			ClientSecret: "secret",
+			Scopes:       []string{"read", "write"},
+		})
+		require.NoError(t, err)
+		now := time.Now()
+		provider.now = func() time.Time { return now }
+
+		// Concurrent requests share a single token request.
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				assert.NoError(t, provider.Intercept(context.Background(), req))
+				assert.Equal(t, "Bearer token1", req.Header.Get("Authorization"))
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+		assert.Equal(t, map[string]string{"grant_type": "client_credentials", "scope": "read write", "basic": "client:secret"}, <-server.forms)
+
+		// Tokens are refreshed shortly before they expire.
+		now = now.Add(time.Hour - 5*time.Second)
+		token, err := provider.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token2", token.AccessToken)
+
+		provider.Invalidate()
+		token, err = provider.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token3", token.AccessToken)
+	})
+
+	t.Run("refresh token", func(t *testing.T) {
+		server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			_, _ = fmt.Fprintf(w, `{"access_token":"token%d","refresh_token":"refresh%d","expires_in":"1"}`, n, n)
+		})
+		provider, err := NewSecurityProviderOAuth2(OAuth2Config{
+			TokenURL:     server.URL,
+			ClientID:     "client",
+			RefreshToken: "refresh0",
+			AuthInParams: true,
+		})
+		require.NoError(t, err)
+
+		for i := 1; i <= 2; i++ {
+			token, err := provider.Token(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("token%d", i), token.AccessToken)
+			assert.Equal(t, map[string]string{
+				"grant_type":    "refresh_token",
+				"refresh_token": fmt.Sprintf("refresh%d", i-1),
+				"client_id":     "client",
+			}, <-server.forms)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"error":"invalid_client","error_description":"unknown client"}`)
+		})
+		provider, err := NewSecurityProviderOAuth2(OAuth2Config{TokenURL: server.URL})
+		require.NoError(t, err)
+
+		err = provider.Intercept(context.Background(), httptest.NewRequest(http.MethodGet, "/", nil))
+		var oauth2Err *OAuth2Error
+		require.True(t, errors.As(err, &oauth2Err))
+		assert.Equal(t, "invalid_client", oauth2Err.Code)
+		assert.EqualError(t, err, "oauth2: token request failed with status 400: invalid_client: unknown client")
+
+		_, err = NewSecurityProviderOAuth2(OAuth2Config{})
+		assert.Equal(t, ErrSecurityProviderOAuth2NoTokenURL, err)
+	})
+}
+
+func TestOAuth2ConfigFromSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: OAuth2
+  version: 1.0.0
+paths: {}
+components:
+  securitySchemes:
+    oauth:
+      type: oauth2
+      flows:
+        authorizationCode:
+          authorizationUrl: https://auth.example.com/authorize
+          tokenUrl: https://auth.example.com/code-token
+          scopes: {}
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            write: Write access
+            read: Read access
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-API-Key
+`))
+	require.NoError(t, err)
+
+	config, err := OAuth2ConfigFromSpec(spec, "oauth")
+	require.NoError(t, err)
+	assert.Equal(t, OAuth2Config{TokenURL: "https://auth.example.com/token", Scopes: []string{"read", "write"}}, config)
+
+	_, err = OAuth2ConfigFromSpec(spec, "apiKey")
+	assert.EqualError(t, err, `security scheme "apiKey" isn't an oauth2 scheme`)
+	_, err = OAuth2ConfigFromSpec(spec, "missing")
+	assert.EqualError(t, err, `no security scheme "missing"`)
+	_, err = OAuth2ConfigFromSpec(&openapi3.T{}, "oauth")
+	assert.EqualError(t, err, `no security scheme "oauth"`)
+	_, err = OAuth2ConfigFromSpec(nil, "oauth")
+	assert.EqualError(t, err, `no security scheme "oauth"`)
+}
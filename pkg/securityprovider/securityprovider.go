@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/ecdsafile"
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
 )
 
 const (
@@ -108,3 +111,37 @@ type SecurityProviderApiKey struct {
 func (s *SecurityProviderApiKey) Intercept(ctx context.Context, req *http.Request) error {
 	return s.interceptor(ctx, req)
 }
+
+// NewSecurityProviderHTTPSignature provides a SecurityProvider, which signs
+// requests as HTTP Message Signatures with the ECDSA, Ed25519 or RSA private
+// key of a PEM encoding, as loaded by ecdsafile.LoadPrivateKey. Servers find
+// the public key of signatures by keyID. Signatures cover the method,
+// authority, path, query and body digest of requests, along with the given
+// headers when present.
+func NewSecurityProviderHTTPSignature(keyID string, privateKeyPEM []byte, headers ...string) (*SecurityProviderHTTPSignature, error) {
+	privateKey, err := ecdsafile.LoadPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := httpsig.NewSigner(privateKey, keyID, headers...)
+	if err != nil {
+		return nil, err
+	}
+	return &SecurityProviderHTTPSignature{
+		signer: signer,
+	}, nil
+}
+
+// SecurityProviderHTTPSignature signs requests as HTTP Message Signatures,
+// as described by RFC 9421, with their Signature-Input and Signature headers.
+type SecurityProviderHTTPSignature struct {
+	signer *httpsig.Signer
+}
+
+// Intercept will attach the Signature-Input, Signature and Content-Digest
+// headers to the request. It must be applied after any other change to the
+// request, as generated clients do with their request signers, which come
+// after their security providers and request editors.
+func (s *SecurityProviderHTTPSignature) Intercept(ctx context.Context, req *http.Request) error {
+	return s.signer.Sign(req)
+}
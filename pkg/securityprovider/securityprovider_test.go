@@ -1,10 +1,21 @@
 package securityprovider
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/deepmap/oapi-codegen/internal/test/client"
+	"github.com/deepmap/oapi-codegen/pkg/ecdsafile"
+	"github.com/deepmap/oapi-codegen/pkg/httpsig"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -40,3 +51,28 @@ func TestSecurityProviders(t *testing.T) {
 	assert.Equal(t, withTrailingSlash, client2.Server)
 	assert.Equal(t, withTrailingSlash, client3.Server)
 }
+
+func TestSecurityProviderHTTPSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	privateKeyPEM, err := ecdsafile.StoreEcdsaPrivateKey(key)
+	require.NoError(t, err)
+
+	provider, err := NewSecurityProviderHTTPSignature("client-1", privateKeyPEM, "Authorization")
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "https://my-api.com/pets?limit=10", strings.NewReader(`{"name":"Fido"}`))
+	req.Header.Set("Authorization", "Bearer mytoken")
+	require.NoError(t, provider.Intercept(context.Background(), req))
+	assert.Contains(t, req.Header.Get("Signature-Input"), `("@method" "@authority" "@path" "@query" "authorization" "content-digest")`)
+
+	verifier := &httpsig.Verifier{
+		Keys:            httpsig.StaticKeys(map[string]crypto.PublicKey{"client-1": &key.PublicKey}),
+		RequiredHeaders: []string{"Authorization"},
+	}
+	keyID, err := verifier.Verify(req)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", keyID)
+
+	_, err = NewSecurityProviderHTTPSignature("client-1", []byte("not a key"))
+	assert.Error(t, err)
+}
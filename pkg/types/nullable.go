@@ -0,0 +1,111 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// ErrNullableIsNull is returned when getting the value of a Nullable which is
+// null.
+var ErrNullableIsNull = errors.New("nullable: value is null")
+
+// ErrNullableNotSpecified is returned when getting the value of a Nullable
+// which wasn't specified.
+var ErrNullableNotSpecified = errors.New("nullable: value is not specified")
+
+// Nullable holds a value which may be absent, null or set, which is how
+// nullable properties of objects behave in JSON. A pointer can only tell two
+// of those states apart.
+//
+// It's a map so that absent values are omitted by the `omitempty` option of
+// JSON struct tags: a nil map is absent, a map holding false is null, and a
+// map holding true holds the value.
+type Nullable[T any] map[bool]T
+
+// NewNullableWithValue returns a Nullable holding value.
+func NewNullableWithValue[T any](value T) Nullable[T] {
+	var n Nullable[T]
+	n.Set(value)
+	return n
+}
+
+// NewNullNullable returns a Nullable which is null.
+func NewNullNullable[T any]() Nullable[T] {
+	var n Nullable[T]
+	n.SetNull()
+	return n
+}
+
+// Get returns the value, or an error when the Nullable is null or wasn't
+// specified.
+func (n Nullable[T]) Get() (T, error) {
+	var empty T
+	if n.IsNull() {
+		return empty, ErrNullableIsNull
+	}
+	if !n.IsSpecified() {
+		return empty, ErrNullableNotSpecified
+	}
+	return n[true], nil
+}
+
+// MustGet returns the value, and panics when the Nullable is null or wasn't
+// specified.
+func (n Nullable[T]) MustGet() T {
+	value, err := n.Get()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Set sets the value.
+func (n *Nullable[T]) Set(value T) {
+	*n = map[bool]T{true: value}
+}
+
+// IsNull returns whether the Nullable was set to null.
+func (n Nullable[T]) IsNull() bool {
+	_, found := n[false]
+	return found
+}
+
+// SetNull sets the Nullable to null.
+func (n *Nullable[T]) SetNull() {
+	var empty T
+	*n = map[bool]T{false: empty}
+}
+
+// IsSpecified returns whether the Nullable was set, to a value or to null.
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// SetUnspecified makes the Nullable absent.
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON encodes the value, or null when the Nullable is null. Absent
+// values are also encoded as null, when they aren't omitted by `omitempty`.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.IsSpecified() || n.IsNull() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n[true])
+}
+
+// UnmarshalJSON decodes the value, or sets the Nullable to null.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		n.SetNull()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nullableObject struct {
+	Optional Nullable[string] `json:"optional,omitempty"`
+	Required Nullable[int]    `json:"required"`
+}
+
+func TestNullableStates(t *testing.T) {
+	var n Nullable[string]
+	assert.False(t, n.IsSpecified())
+	assert.False(t, n.IsNull())
+	_, err := n.Get()
+	assert.ErrorIs(t, err, ErrNullableNotSpecified)
+
+	n.SetNull()
+	assert.True(t, n.IsSpecified())
+	assert.True(t, n.IsNull())
+	_, err = n.Get()
+	assert.ErrorIs(t, err, ErrNullableIsNull)
+	assert.Panics(t, func() { n.MustGet() })
+
+	n.Set("")
+	assert.True(t, n.IsSpecified())
+	assert.False(t, n.IsNull())
+	assert.Equal(t, "", n.MustGet())
+
+	n.SetUnspecified()
+	assert.False(t, n.IsSpecified())
+
+	assert.Equal(t, 5, NewNullableWithValue(5).MustGet())
+	assert.True(t, NewNullNullable[int]().IsNull())
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var obj nullableObject
+	require.NoError(t, json.Unmarshal([]byte(`{"required": 3}`), &obj))
+	assert.False(t, obj.Optional.IsSpecified())
+	assert.Equal(t, 3, obj.Required.MustGet())
+
+	obj = nullableObject{}
+	require.NoError(t, json.Unmarshal([]byte(`{"optional": null, "required": null}`), &obj))
+	assert.True(t, obj.Optional.IsNull())
+	assert.True(t, obj.Required.IsNull())
+
+	obj = nullableObject{}
+	require.NoError(t, json.Unmarshal([]byte(`{"optional": "value"}`), &obj))
+	assert.Equal(t, "value", obj.Optional.MustGet())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"required": "three"}`), &obj))
+}
+
+func TestNullableMarshalJSON(t *testing.T) {
+	buf, err := json.Marshal(nullableObject{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"required": null}`, string(buf))
+
+	buf, err = json.Marshal(nullableObject{
+		Optional: NewNullNullable[string](),
+		Required: NewNullableWithValue(0),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"optional": null, "required": 0}`, string(buf))
+
+	buf, err = json.Marshal(nullableObject{Optional: NewNullableWithValue("value")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"optional": "value", "required": null}`, string(buf))
+}
@@ -10,6 +10,10 @@ func LoadSwagger(filePath string) (swagger *openapi3.T, err error) {
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
+	// OpenAPI 3.1 specs are converted into the OpenAPI 3.0 specs which the
+	// generator works with as they're read.
+	converter := &openAPI31Converter{}
+	loader.ReadFromURIFunc = converter.readFromURI
 
 	u, err := url.Parse(filePath)
 	if err == nil && u.Scheme != "" && u.Host != "" {
@@ -0,0 +1,542 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v2"
+)
+
+// openAPI31Converter rewrites OpenAPI 3.1 documents into the OpenAPI 3.0
+// documents which kin-openapi, and so the code generator, understand. It's
+// used to read every document of a spec, so that the documents referenced by
+// a 3.1 spec are converted too.
+//
+// The JSON Schema 2020-12 constructs of 3.1 are mapped onto their 3.0
+// equivalents:
+//   - type arrays with "null" become nullable schemas of the other type, and
+//     null alternatives of oneOf and anyOf make the schema nullable;
+//   - const becomes a single-value enum;
+//   - examples arrays become an example, exclusiveMinimum and
+//     exclusiveMaximum values become minimum and maximum;
+//   - base64 contentEncoding and binary contentMediaType become the byte and
+//     binary formats;
+//   - $defs are moved to components/schemas;
+//   - webhooks are kept in the x-webhooks extension of the spec.
+//
+// Other 3.1 keywords are kept as schema extensions, which the generator
+// ignores.
+type openAPI31Converter struct {
+	// read tells whether the root document has been read.
+	read bool
+	// enabled tells whether the root document is an OpenAPI 3.1 document.
+	enabled bool
+}
+
+// readFromURI reads the document at location, and converts it when it's part
+// of an OpenAPI 3.1 spec.
+func (c *openAPI31Converter) readFromURI(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	data, err := openapi3.DefaultReadFromURI(loader, location)
+	if err != nil {
+		return nil, err
+	}
+
+	root := !c.read
+	c.read = true
+	if root {
+		var header struct {
+			OpenAPI string `yaml:"openapi"`
+		}
+		// Documents which can't be parsed are left to kin-openapi to report.
+		if yaml.Unmarshal(data, &header) != nil || !strings.HasPrefix(header.OpenAPI, "3.1") {
+			return data, nil
+		}
+		c.enabled = true
+	}
+	if !c.enabled {
+		return data, nil
+	}
+
+	converted, err := convertOpenAPI31(data, root)
+	if err != nil {
+		return nil, fmt.Errorf("error converting OpenAPI 3.1 document %s: %w", location, err)
+	}
+	return converted, nil
+}
+
+// convertOpenAPI31 converts an OpenAPI 3.1 document, in YAML or JSON, into an
+// OpenAPI 3.0 document in JSON. Documents which aren't the root of the spec
+// are fragments holding schemas or other components, which are converted
+// without the changes that only apply to whole specs.
+func convertOpenAPI31(data []byte, root bool) ([]byte, error) {
+	var parsed interface{}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing document: %w", err)
+	}
+	doc, err := normalizeYAML(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec, ok := doc.(map[string]interface{}); ok && root {
+		convertSpec(spec)
+	} else {
+		convertNode(doc, "")
+	}
+	return json.Marshal(doc)
+}
+
+// normalizeYAML converts the maps decoded from YAML, which have keys of any
+// type, into maps with string keys, which can be encoded to JSON.
+func normalizeYAML(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized, err := normalizeYAML(item)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(key)] = normalized
+		}
+		return m, nil
+	case []interface{}:
+		for i, item := range v {
+			normalized, err := normalizeYAML(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = normalized
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// hoistedSchema is a schema of $defs moved to components/schemas.
+type hoistedSchema struct {
+	// ref is the reference to the schema at its original location.
+	ref string
+	// name is the name of the schema in $defs.
+	name string
+	// parent is the name of the component schema holding the $defs, if any.
+	parent string
+	schema interface{}
+	// owner is the object holding the $defs.
+	owner map[string]interface{}
+}
+
+// convertSpec converts the root document of an OpenAPI 3.1 spec.
+func convertSpec(spec map[string]interface{}) {
+	spec["openapi"] = "3.0.3"
+	delete(spec, "jsonSchemaDialect")
+	if _, ok := spec["paths"]; !ok {
+		spec["paths"] = map[string]interface{}{}
+	}
+
+	// Schemas are hoisted in the order of their keys, so that the names given
+	// to colliding $defs are the same from one run to the next.
+	var hoisted []hoistedSchema
+	for _, key := range sortedKeys(spec) {
+		if key != "$defs" && !strings.HasPrefix(key, "x-") {
+			hoisted = append(hoisted, convertNode(spec[key], "/"+escapePointerToken(key))...)
+		}
+	}
+	if defs, ok := spec["$defs"].(map[string]interface{}); ok {
+		hoisted = append(hoisted, convertDefs(spec, defs, "", "")...)
+	}
+
+	components, _ := spec["components"].(map[string]interface{})
+	if components == nil {
+		components = map[string]interface{}{}
+	}
+
+	if len(hoisted) != 0 {
+		schemas, _ := components["schemas"].(map[string]interface{})
+		if schemas == nil {
+			schemas = map[string]interface{}{}
+			components["schemas"] = schemas
+		}
+		refs := map[string]string{}
+		for _, h := range hoisted {
+			name := uniqueSchemaName(schemas, h)
+			schemas[name] = h.schema
+			refs[h.ref] = "#/components/schemas/" + escapePointerToken(name)
+			delete(h.owner, "$defs")
+		}
+		rewriteRefs(spec, refs)
+	}
+
+	// Path item components are new in 3.1, so references to them are
+	// replaced with the path items themselves.
+	if pathItems, ok := components["pathItems"].(map[string]interface{}); ok {
+		for _, key := range []string{"paths", "webhooks"} {
+			if items, ok := spec[key].(map[string]interface{}); ok {
+				inlinePathItems(items, pathItems)
+			}
+		}
+		delete(components, "pathItems")
+	}
+
+	if len(components) != 0 {
+		spec["components"] = components
+	}
+	if webhooks, ok := spec["webhooks"]; ok {
+		spec["x-webhooks"] = webhooks
+		delete(spec, "webhooks")
+	}
+}
+
+// convertNode converts the schemas found within a value of an OpenAPI
+// document, at the JSON pointer path, and returns the schemas of the $defs
+// it found.
+func convertNode(value interface{}, path string) []hoistedSchema {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if isSchema(v) {
+			return convertSchema(v, path)
+		}
+		var hoisted []hoistedSchema
+		for _, key := range sortedKeys(v) {
+			item := v[key]
+			itemPath := path + "/" + escapePointerToken(key)
+			switch {
+			case strings.HasPrefix(key, "x-") || key == "example" || key == "examples":
+				// Extensions and examples hold values rather than schemas.
+			case key == "schema":
+				hoisted = append(hoisted, convertSchema(item, itemPath)...)
+			case key == "schemas" || key == "$defs":
+				if schemas, ok := item.(map[string]interface{}); ok {
+					for _, name := range sortedKeys(schemas) {
+						hoisted = append(hoisted, convertSchema(schemas[name], itemPath+"/"+escapePointerToken(name))...)
+					}
+				}
+			default:
+				hoisted = append(hoisted, convertNode(item, itemPath)...)
+			}
+		}
+		return hoisted
+	case []interface{}:
+		var hoisted []hoistedSchema
+		for i, item := range v {
+			hoisted = append(hoisted, convertNode(item, fmt.Sprintf("%s/%d", path, i))...)
+		}
+		return hoisted
+	default:
+		return nil
+	}
+}
+
+// isSchema tells whether the object m, found outside of a schema, looks like
+// a schema. It's used for the documents referenced by a spec, which may hold
+// schemas anywhere.
+func isSchema(m map[string]interface{}) bool {
+	for _, key := range []string{"type", "properties", "items", "allOf", "anyOf", "oneOf", "const", "$defs"} {
+		if _, ok := m[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// convertSchema converts the schema value, at the JSON pointer path, and the
+// schemas it contains, and returns the schemas of the $defs it found, which
+// are moved to components/schemas in specs.
+func convertSchema(value interface{}, path string) []hoistedSchema {
+	s, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if nullable := convertType(s); nullable {
+		s["nullable"] = true
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if removeNullAlternatives(s, key) {
+			s["nullable"] = true
+		}
+	}
+
+	if value, ok := s["const"]; ok {
+		if value == nil {
+			s["nullable"] = true
+		} else if _, ok := s["enum"]; !ok {
+			s["enum"] = []interface{}{value}
+		}
+		delete(s, "const")
+	}
+	if values, ok := s["enum"].([]interface{}); ok {
+		enum := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			if value == nil {
+				s["nullable"] = true
+				continue
+			}
+			enum = append(enum, value)
+		}
+		if len(enum) != 0 {
+			s["enum"] = enum
+		} else {
+			delete(s, "enum")
+		}
+	}
+
+	if examples, ok := s["examples"].([]interface{}); ok {
+		if _, ok := s["example"]; !ok && len(examples) != 0 {
+			s["example"] = examples[0]
+		}
+		delete(s, "examples")
+	}
+
+	for key, bound := range map[string]string{"exclusiveMinimum": "minimum", "exclusiveMaximum": "maximum"} {
+		if _, ok := s[key].(bool); ok {
+			continue
+		}
+		if value, ok := s[key]; ok {
+			s[bound] = value
+			s[key] = true
+		}
+	}
+
+	if _, ok := s["format"]; !ok && s["type"] == "string" {
+		encoding, _ := s["contentEncoding"].(string)
+		mediaType, _ := s["contentMediaType"].(string)
+		switch {
+		case encoding == "base64" || encoding == "base64url":
+			s["format"] = "byte"
+		case encoding == "" && mediaType != "" && !IsMediaTypeJson(mediaType):
+			s["format"] = "binary"
+		}
+	}
+
+	var hoisted []hoistedSchema
+	if properties, ok := s["properties"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(properties) {
+			hoisted = append(hoisted, convertSchema(properties[name], path+"/properties/"+escapePointerToken(name))...)
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		hoisted = append(hoisted, convertSchema(s[key], path+"/"+key)...)
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if schemas, ok := s[key].([]interface{}); ok {
+			for i, schema := range schemas {
+				hoisted = append(hoisted, convertSchema(schema, fmt.Sprintf("%s/%s/%d", path, key, i))...)
+			}
+		}
+	}
+	if defs, ok := s["$defs"].(map[string]interface{}); ok {
+		parent := ""
+		if strings.HasPrefix(path, "/components/schemas/") && strings.Count(path, "/") == 3 {
+			parent = unescapePointerToken(strings.TrimPrefix(path, "/components/schemas/"))
+		}
+		hoisted = append(hoisted, convertDefs(s, defs, path, parent)...)
+	}
+	return hoisted
+}
+
+// convertDefs converts the schemas of the $defs of owner, the schema at the
+// JSON pointer path, and returns them to be moved to components/schemas.
+func convertDefs(owner map[string]interface{}, defs map[string]interface{}, path string, parent string) []hoistedSchema {
+	var hoisted []hoistedSchema
+	for _, name := range sortedKeys(defs) {
+		schema := defs[name]
+		defPath := path + "/$defs/" + escapePointerToken(name)
+		hoisted = append(hoisted, convertSchema(schema, defPath)...)
+		hoisted = append(hoisted, hoistedSchema{
+			ref:    "#" + defPath,
+			name:   name,
+			parent: parent,
+			schema: schema,
+			owner:  owner,
+		})
+	}
+	return hoisted
+}
+
+// convertType converts a type array into a single type, and tells whether it
+// allowed null values. Arrays of several other types leave the schema
+// without a type, which accepts any value.
+func convertType(s map[string]interface{}) bool {
+	switch t := s["type"].(type) {
+	case string:
+		if t == "null" {
+			delete(s, "type")
+			return true
+		}
+	case []interface{}:
+		nullable := false
+		var types []interface{}
+		for _, item := range t {
+			if item == "null" {
+				nullable = true
+			} else {
+				types = append(types, item)
+			}
+		}
+		if len(types) == 1 {
+			s["type"] = types[0]
+		} else {
+			delete(s, "type")
+		}
+		return nullable
+	}
+	return false
+}
+
+// removeNullAlternatives removes the schemas which only accept null from the
+// oneOf or anyOf of s, and tells whether there were any. A single remaining
+// alternative replaces the oneOf or anyOf.
+func removeNullAlternatives(s map[string]interface{}, key string) bool {
+	alternatives, ok := s[key].([]interface{})
+	if !ok {
+		return false
+	}
+	var remaining []interface{}
+	for _, alternative := range alternatives {
+		if !isNullSchema(alternative) {
+			remaining = append(remaining, alternative)
+		}
+	}
+	if len(remaining) == len(alternatives) {
+		return false
+	}
+
+	switch {
+	case len(remaining) == 0:
+		delete(s, key)
+	case len(remaining) > 1:
+		s[key] = remaining
+	default:
+		delete(s, key)
+		alternative, _ := remaining[0].(map[string]interface{})
+		if _, ok := alternative["$ref"]; ok {
+			// Siblings of references are ignored, so the reference is wrapped
+			// in an allOf to keep the schema nullable.
+			allOf, _ := s["allOf"].([]interface{})
+			s["allOf"] = append(allOf, alternative)
+			break
+		}
+		for k, v := range alternative {
+			if _, ok := s[k]; !ok {
+				s[k] = v
+			}
+		}
+	}
+	return true
+}
+
+// isNullSchema tells whether value is a schema which only accepts null.
+func isNullSchema(value interface{}) bool {
+	s, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	switch t := s["type"].(type) {
+	case string:
+		return t == "null"
+	case []interface{}:
+		return len(t) == 1 && t[0] == "null"
+	}
+	if value, ok := s["const"]; ok {
+		return value == nil
+	}
+	return false
+}
+
+// uniqueSchemaName returns the name to give to the hoisted schema h in
+// components/schemas, which mustn't be taken already.
+func uniqueSchemaName(schemas map[string]interface{}, h hoistedSchema) string {
+	candidates := []string{h.name}
+	if h.parent != "" {
+		candidates = append(candidates, h.parent+h.name)
+	}
+	for _, candidate := range candidates {
+		if _, taken := schemas[candidate]; !taken {
+			return candidate
+		}
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s%d", candidates[len(candidates)-1], i)
+		if _, taken := schemas[candidate]; !taken {
+			return candidate
+		}
+	}
+}
+
+// rewriteRefs replaces the local references to the moved schemas of refs,
+// and to the schemas they contain, within value.
+func rewriteRefs(value interface{}, refs map[string]string) {
+	// Longer references are replaced first, so that the references to nested
+	// $defs are replaced by theirs.
+	from := make([]string, 0, len(refs))
+	for ref := range refs {
+		from = append(from, ref)
+	}
+	sort.Slice(from, func(i, j int) bool {
+		return len(from[i]) > len(from[j])
+	})
+
+	var rewrite func(value interface{})
+	rewrite = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				for _, f := range from {
+					if ref == f || strings.HasPrefix(ref, f+"/") {
+						v["$ref"] = refs[f] + strings.TrimPrefix(ref, f)
+						break
+					}
+				}
+			}
+			for _, item := range v {
+				rewrite(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				rewrite(item)
+			}
+		}
+	}
+	rewrite(value)
+}
+
+// inlinePathItems replaces the references to path item components within
+// items with the path items.
+func inlinePathItems(items map[string]interface{}, pathItems map[string]interface{}) {
+	const prefix = "#/components/pathItems/"
+	for key, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ref, ok := m["$ref"].(string); ok && strings.HasPrefix(ref, prefix) {
+			if pathItem, ok := pathItems[unescapePointerToken(strings.TrimPrefix(ref, prefix))]; ok {
+				items[key] = pathItem
+			}
+		}
+	}
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
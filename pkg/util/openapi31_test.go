@@ -0,0 +1,198 @@
+package util
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertOpenAPI31Schemas(t *testing.T) {
+	spec := `
+openapi: 3.1.0
+info: {title: test, version: 1.0.0}
+components:
+  schemas:
+    Value:
+      type: object
+      properties:
+        nullableString: {type: [string, "null"]}
+        onlyNull: {type: "null"}
+        anything: {type: [string, integer]}
+        constant: {const: 3}
+        withExamples: {type: string, examples: [a, b]}
+        bounded: {type: number, exclusiveMinimum: 0, exclusiveMaximum: 10}
+        encoded: {type: string, contentEncoding: base64}
+        file: {type: string, contentMediaType: image/png}
+        nullableEnum: {type: string, enum: [a, null]}
+        nullableRef:
+          anyOf:
+            - $ref: '#/components/schemas/Other'
+            - type: "null"
+    Other: {type: string}
+`
+	converted, err := convertOpenAPI31([]byte(spec), true)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(converted, &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	assert.Equal(t, map[string]interface{}{}, doc["paths"])
+
+	properties := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})["Value"].(map[string]interface{})["properties"]
+	expected := `{
+		"nullableString": {"type": "string", "nullable": true},
+		"onlyNull": {"nullable": true},
+		"anything": {},
+		"constant": {"enum": [3]},
+		"withExamples": {"type": "string", "example": "a"},
+		"bounded": {"type": "number", "minimum": 0, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": true},
+		"encoded": {"type": "string", "format": "byte", "contentEncoding": "base64"},
+		"file": {"type": "string", "format": "binary", "contentMediaType": "image/png"},
+		"nullableEnum": {"type": "string", "enum": ["a"], "nullable": true},
+		"nullableRef": {"allOf": [{"$ref": "#/components/schemas/Other"}], "nullable": true}
+	}`
+	buf, err := json.Marshal(properties)
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, string(buf))
+}
+
+func TestConvertOpenAPI31Spec(t *testing.T) {
+	spec := `
+openapi: 3.1.0
+info: {title: test, version: 1.0.0}
+jsonSchemaDialect: https://spec.openapis.org/oas/3.1/dialect/base
+paths:
+  /things:
+    $ref: '#/components/pathItems/Things'
+webhooks:
+  thing:
+    post:
+      responses:
+        '200': {description: ok}
+components:
+  pathItems:
+    Things:
+      get:
+        responses:
+          '200':
+            description: ok
+            content:
+              application/json:
+                schema: {$ref: '#/$defs/Thing'}
+  schemas:
+    Thing: {type: string}
+    Holder:
+      type: object
+      properties:
+        kind: {$ref: '#/components/schemas/Holder/$defs/Kind'}
+      $defs:
+        Kind: {type: string}
+$defs:
+  Thing:
+    type: object
+    properties:
+      name: {type: string}
+`
+	converted, err := convertOpenAPI31([]byte(spec), true)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(converted, &doc))
+	assert.NotContains(t, doc, "jsonSchemaDialect")
+	assert.NotContains(t, doc, "$defs")
+	assert.NotContains(t, doc, "webhooks")
+	assert.Contains(t, doc, "x-webhooks")
+
+	components := doc["components"].(map[string]interface{})
+	assert.NotContains(t, components, "pathItems")
+	schemas := components["schemas"].(map[string]interface{})
+	assert.Contains(t, schemas, "Thing2")
+	assert.Contains(t, schemas, "Kind")
+	assert.NotContains(t, schemas["Holder"], "$defs")
+
+	buf, err := json.Marshal(doc["paths"])
+	require.NoError(t, err)
+	assert.Contains(t, string(buf), `"$ref":"#/components/schemas/Thing2"`)
+	buf, err = json.Marshal(schemas["Holder"])
+	require.NoError(t, err)
+	assert.Contains(t, string(buf), `"$ref":"#/components/schemas/Kind"`)
+}
+
+func TestConvertOpenAPI31CollidingDefs(t *testing.T) {
+	spec := `
+openapi: 3.1.0
+info: {title: test, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    A:
+      type: object
+      properties:
+        kind: {$ref: '#/components/schemas/A/$defs/Kind'}
+      $defs:
+        Kind: {type: string}
+    B:
+      type: object
+      properties:
+        kind: {$ref: '#/components/schemas/B/$defs/Kind'}
+      $defs:
+        Kind: {type: integer}
+$defs:
+  Kind: {type: boolean}
+`
+	// The names given to colliding $defs don't depend on the order of the
+	// iteration over maps.
+	var first []byte
+	for i := 0; i < 20; i++ {
+		converted, err := convertOpenAPI31([]byte(spec), true)
+		require.NoError(t, err)
+		if first == nil {
+			first = converted
+			continue
+		}
+		require.Equal(t, string(first), string(converted))
+	}
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(first, &doc))
+	schemas := doc.Components.Schemas
+	assert.Equal(t, "string", schemas["Kind"]["type"])
+	assert.Equal(t, "integer", schemas["BKind"]["type"])
+	assert.Equal(t, "boolean", schemas["Kind2"]["type"])
+}
+
+func TestLoadSwaggerOpenAPI31(t *testing.T) {
+	dir := t.TempDir()
+	spec := `
+openapi: 3.1.0
+info: {title: test, version: 1.0.0}
+paths: {}
+components:
+  schemas:
+    Pet:
+      $ref: 'schemas.yaml#/Pet'
+`
+	schemas := `
+Pet:
+  type: object
+  properties:
+    tag: {type: [string, "null"]}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "spec.yaml"), []byte(spec), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schemas.yaml"), []byte(schemas), 0o644))
+
+	swagger, err := LoadSwagger(filepath.Join(dir, "spec.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.3", swagger.OpenAPI)
+	tag := swagger.Components.Schemas["Pet"].Value.Properties["tag"].Value
+	assert.Equal(t, "string", tag.Type)
+	assert.True(t, tag.Nullable)
+}